@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"github.com/opengovern/og-describer-azure/service"
+	"github.com/spf13/cobra"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	cmd := &cobra.Command{
+		Use:   "og-azure-cli",
+		Short: "Run azure describers locally",
+	}
+	cmd.AddCommand(local.DescribeCommand())
+
+	// cobra already reports the error on stderr; stdout carries the
+	// described resources.
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,130 @@
+package describer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"github.com/opengovern/og-util/pkg/es"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	MaxBatchBytes int = 3 * 1024 * 1024
+
+	CompressionNone string = "none"
+	CompressionGzip string = "gzip"
+)
+
+type BatchConfig struct {
+	// MinDocs is the buffered resource count the periodic flush waits for.
+	MinDocs int
+	// MaxDocs and MaxBytes cap a single batch, whichever is reached first.
+	// MaxBytes is measured on the serialized documents.
+	MaxDocs  int
+	MaxBytes int
+	// Compression is applied to the grpc and http payloads, gzip or none.
+	// It is off unless DESCRIBE_SINK_COMPRESSION asks for gzip, as not every
+	// deployed sink accepts compressed payloads.
+	Compression string
+}
+
+func BatchConfigFromEnv() BatchConfig {
+	cfg := BatchConfig{
+		MinDocs:     MinBufferSize,
+		MaxDocs:     MaxBufferSize,
+		MaxBytes:    MaxBatchBytes,
+		Compression: CompressionNone,
+	}
+	if v, err := strconv.Atoi(os.Getenv("DESCRIBE_BATCH_MIN_DOCS")); err == nil && v > 0 {
+		cfg.MinDocs = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("DESCRIBE_BATCH_MAX_DOCS")); err == nil && v > 0 {
+		cfg.MaxDocs = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("DESCRIBE_BATCH_MAX_BYTES")); err == nil && v > 0 {
+		cfg.MaxBytes = v
+	}
+	if v := strings.ToLower(os.Getenv("DESCRIBE_SINK_COMPRESSION")); v != "" {
+		cfg.Compression = v
+	}
+	return cfg
+}
+
+// encodedDoc is a document that is already serialized. It keeps the keys and
+// index of the original document so it can be handed to any sink, and is
+// what the spool stores.
+type encodedDoc struct {
+	Keys       []string        `json:"keys"`
+	Index      string          `json:"index"`
+	ResourceID string          `json:"resourceID"`
+	Doc        json.RawMessage `json:"doc"`
+}
+
+func (d encodedDoc) KeysAndIndex() ([]string, string) {
+	return d.Keys, d.Index
+}
+
+func (d encodedDoc) MarshalJSON() ([]byte, error) {
+	return d.Doc, nil
+}
+
+func encodeDoc(doc es.Doc) (encodedDoc, error) {
+	if ed, ok := doc.(encodedDoc); ok {
+		return ed, nil
+	}
+	docBytes, err := json.Marshal(doc)
+	if err != nil {
+		return encodedDoc{}, err
+	}
+	keys, idx := doc.KeysAndIndex()
+	return encodedDoc{
+		Keys:       keys,
+		Index:      idx,
+		ResourceID: resourceIDOf(doc),
+		Doc:        docBytes,
+	}, nil
+}
+
+// docSize is the serialized size of doc, only known for encoded documents.
+func docSize(doc es.Doc) int {
+	if ed, ok := doc.(encodedDoc); ok {
+		return len(ed.Doc)
+	}
+	return 0
+}
+
+// splitBatch splits docs into batches of at most maxDocs documents and
+// maxBytes serialized bytes. A document larger than maxBytes is sent alone.
+func splitBatch(docs []es.Doc, maxDocs, maxBytes int) [][]es.Doc {
+	var batches [][]es.Doc
+	var current []es.Doc
+	currentBytes := 0
+	for _, doc := range docs {
+		size := docSize(doc)
+		if len(current) > 0 && ((maxDocs > 0 && len(current) >= maxDocs) || (maxBytes > 0 && currentBytes+size > maxBytes)) {
+			batches = append(batches, current)
+			current = nil
+			currentBytes = 0
+		}
+		current = append(current, doc)
+		currentBytes += size
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
+
+func gzipBytes(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
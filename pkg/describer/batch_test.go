@@ -0,0 +1,63 @@
+package describer
+
+import (
+	"github.com/opengovern/og-util/pkg/es"
+	"strings"
+	"testing"
+)
+
+func TestSplitBatch(t *testing.T) {
+	doc := func(size int) es.Doc {
+		return encodedDoc{Doc: []byte(strings.Repeat("a", size))}
+	}
+
+	tests := []struct {
+		name     string
+		docs     []es.Doc
+		maxDocs  int
+		maxBytes int
+		want     []int
+	}{
+		{
+			name:     "Empty",
+			docs:     nil,
+			maxDocs:  10,
+			maxBytes: 100,
+			want:     nil,
+		},
+		{
+			name:     "Count",
+			docs:     []es.Doc{doc(1), doc(1), doc(1), doc(1), doc(1)},
+			maxDocs:  2,
+			maxBytes: 100,
+			want:     []int{2, 2, 1},
+		},
+		{
+			name:     "Bytes",
+			docs:     []es.Doc{doc(40), doc(40), doc(40), doc(10)},
+			maxDocs:  10,
+			maxBytes: 100,
+			want:     []int{2, 2},
+		},
+		{
+			name:     "Oversized",
+			docs:     []es.Doc{doc(10), doc(500), doc(10)},
+			maxDocs:  10,
+			maxBytes: 100,
+			want:     []int{1, 1, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitBatch(tt.docs, tt.maxDocs, tt.maxBytes)
+			if len(got) != len(tt.want) {
+				t.Fatalf("splitBatch() returned %d batches, want %d", len(got), len(tt.want))
+			}
+			for i, batch := range got {
+				if len(batch) != tt.want[i] {
+					t.Errorf("batch %d has %d docs, want %d", i, len(batch), tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -7,9 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/opengovern/og-describer-azure/pkg/metrics"
+	"github.com/opengovern/og-describer-azure/pkg/tracing"
+	"github.com/opengovern/og-describer-azure/provider/describer"
 	describepkg "github.com/opengovern/og-util/pkg/describe"
 	"github.com/opengovern/og-util/pkg/vault"
 	"github.com/opengovern/og-util/proto/src/golang"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -25,8 +30,15 @@ import (
 const (
 	DescribeResourceJobFailed    string = "FAILED"
 	DescribeResourceJobSucceeded string = "SUCCEEDED"
+	DescribeResourceJobPartial   string = "PARTIAL"
 )
 
+const ErrCodeWorkerShutdown = "WorkerShutdown"
+
+// ErrWorkerShutdown is the cause the worker cancels its jobs with when it
+// shuts down, the jobs still report a result for what they got done.
+var ErrWorkerShutdown = errors.New("worker shutdown")
+
 func getJWTAuthToken() (string, error) {
 	privateKey, ok := os.LookupEnv("JWT_PRIVATE_KEY")
 	if !ok {
@@ -62,8 +74,15 @@ const (
 
 // DescribeHandler
 // TriggeredBy is not used for now but might be relevant in the future
-func DescribeHandler(ctx context.Context, logger *zap.Logger, _ TriggeredBy, input describepkg.DescribeWorkerInput) error {
-	var err error
+func DescribeHandler(ctx context.Context, logger *zap.Logger, _ TriggeredBy, input describepkg.DescribeWorkerInput, sinkCfg SinkConfig) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "DescribeHandler", trace.WithAttributes(
+		attribute.Int64("job.id", int64(input.DescribeJob.JobID)),
+		attribute.String("job.resource_type", input.DescribeJob.ResourceType),
+		attribute.String("job.integration_id", input.DescribeJob.IntegrationID),
+	))
+	defer func() {
+		tracing.End(span, err)
+	}()
 	defer func() {
 		if r := recover(); r != nil {
 			fmt.Printf("There is a Panic: %v", r)
@@ -71,6 +90,20 @@ func DescribeHandler(ctx context.Context, logger *zap.Logger, _ TriggeredBy, inp
 		logger.Sync()
 	}()
 
+	startTime := time.Now()
+	resourceType := input.DescribeJob.ResourceType
+	metrics.JobsStarted.WithLabelValues(resourceType).Inc()
+	status := DescribeResourceJobFailed
+	errCode := ""
+	defer func() {
+		if status == DescribeResourceJobSucceeded || status == DescribeResourceJobPartial {
+			metrics.JobsSucceeded.WithLabelValues(resourceType).Inc()
+		} else {
+			metrics.JobsFailed.WithLabelValues(resourceType, errCode).Inc()
+		}
+		metrics.JobDuration.WithLabelValues(resourceType, status).Observe(time.Since(startTime).Seconds())
+	}()
+
 	var token string
 	if input.EndpointAuth {
 		token, err = getJWTAuthToken()
@@ -80,7 +113,6 @@ func DescribeHandler(ctx context.Context, logger *zap.Logger, _ TriggeredBy, inp
 	}
 
 	var client golang.DescribeServiceClient
-	grpcCtx := metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{}))
 
 	logger.Info("Setting grpc connection opts")
 	var opts []grpc.DialOption
@@ -113,13 +145,15 @@ func DescribeHandler(ctx context.Context, logger *zap.Logger, _ TriggeredBy, inp
 	}
 
 	logger.Info("Setting job in progress")
+	inProgressCtx, inProgressSpan := tracing.Tracer().Start(ctx, "SetInProgress")
 	for retry := 0; retry < 5; retry++ {
-		_, err := client.SetInProgress(grpcCtx, &golang.SetInProgressRequest{
+		_, err := client.SetInProgress(grpcContext(inProgressCtx), &golang.SetInProgressRequest{
 			JobId: uint32(input.DescribeJob.JobID),
 		})
 		if err != nil {
 			logger.Error("[result delivery] set in progress failure:", zap.Error(err))
 			if retry == 4 {
+				tracing.End(inProgressSpan, err)
 				return err
 			}
 			time.Sleep(1 * time.Second)
@@ -127,6 +161,7 @@ func DescribeHandler(ctx context.Context, logger *zap.Logger, _ TriggeredBy, inp
 		}
 		break
 	}
+	inProgressSpan.End()
 
 	var vaultSc vault.VaultSourceConfig
 	switch input.VaultConfig.Provider {
@@ -151,34 +186,64 @@ func DescribeHandler(ctx context.Context, logger *zap.Logger, _ TriggeredBy, inp
 	for k, v := range input.ExtraInputs {
 		ctx = context.WithValue(ctx, k, v)
 	}
+	if partialSuccessEnabled(input.ExtraInputs) {
+		ctx = describer.WithPartialSuccess(ctx)
+	}
+	if resourceID := refreshResourceOf(input.ExtraInputs); resourceID != "" {
+		logger.Info("Refreshing resource", zap.String("resourceID", resourceID))
+		ctx = withRefreshResource(ctx, resourceID)
+	} else {
+		ctx = withJobScope(ctx, input.DescribeJob, input.ExtraInputs)
+	}
+	ctx = withManagementGroupPath(ctx, input.ExtraInputs)
+	ctx = withParentCache(ctx)
 
-	resourceIds, err := Do(
-		ctx,
-		vaultSc,
-		logger,
-		input.DescribeJob,
-		input.DeliverEndpoint,
-		token,
-		input.IngestionPipelineEndpoint,
-		input.UseOpenSearch,
-	)
+	var resourceIds []string
+	jobSinkCfg, describeErr := sinkCfg.WithJobOverrides(input.ExtraInputs)
+	if describeErr != nil {
+		describeErr = Permanent(describeErr)
+	} else {
+		resourceIds, describeErr = Do(
+			ctx,
+			vaultSc,
+			logger,
+			input.DescribeJob,
+			input.DeliverEndpoint,
+			token,
+			input.IngestionPipelineEndpoint,
+			input.UseOpenSearch,
+			jobSinkCfg,
+		)
+	}
 	logger.Info("Resource IDs fetched", zap.Any("resourceIds", resourceIds))
+	if describeErr != nil && errors.Is(context.Cause(ctx), ErrWorkerShutdown) {
+		describeErr = Error{ErrCode: ErrCodeWorkerShutdown, error: ErrWorkerShutdown}
+	}
 
 	errMsg := ""
-	errCode := ""
-	status := DescribeResourceJobSucceeded
-	if err != nil {
-		errMsg = err.Error()
+	status = DescribeResourceJobSucceeded
+	if describeErr != nil {
+		errMsg = describeErr.Error()
 		var kerr Error
-		if errors.As(err, &kerr) {
+		if errors.As(describeErr, &kerr) {
 			errCode = kerr.ErrCode
 		}
 		status = DescribeResourceJobFailed
+
+		var partialErr *PartialError
+		if errors.As(describeErr, &partialErr) {
+			errMsg = partialErr.Report()
+			errCode = ErrCodePartialSuccess
+			status = DescribeResourceJobPartial
+		}
 	}
 
 	logger.Info("Delivering result")
+	deliverCtx, deliverSpan := tracing.Tracer().Start(ctx, "DeliverResult", trace.WithAttributes(
+		attribute.String("job.status", status),
+	))
 	for retry := 0; retry < 5; retry++ {
-		_, err = client.DeliverResult(grpcCtx, &golang.DeliverResultRequest{
+		_, err = client.DeliverResult(grpcContext(deliverCtx), &golang.DeliverResultRequest{
 			JobId:     uint32(input.DescribeJob.JobID),
 			Status:    status,
 			Error:     errMsg,
@@ -203,7 +268,24 @@ func DescribeHandler(ctx context.Context, logger *zap.Logger, _ TriggeredBy, inp
 		}
 		break
 	}
+	tracing.End(deliverSpan, err)
+	if err != nil {
+		return fmt.Errorf("failed to deliver result: %w", err)
+	}
 
 	logger.Info("job done", zap.Uint("jobID", input.DescribeJob.JobID))
+	// the failure is reported already, permanent ones are also handed back so
+	// the job ends up in the dead letter queue instead of being retried
+	if IsPermanent(describeErr) {
+		return describeErr
+	}
 	return nil
 }
+
+// grpcContext carries the trace of ctx but not its cancellation, so the
+// result is delivered even if the job is cancelled.
+func grpcContext(ctx context.Context) context.Context {
+	md := metadata.New(map[string]string{})
+	tracing.InjectMetadata(ctx, md)
+	return metadata.NewOutgoingContext(context.WithoutCancel(ctx), md)
+}
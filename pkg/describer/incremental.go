@@ -0,0 +1,444 @@
+package describer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/opengovern/og-describer-azure/provider/configs"
+	describe2 "github.com/opengovern/og-util/pkg/describe"
+	"github.com/opengovern/og-util/pkg/es"
+	"github.com/opengovern/og-util/pkg/integration"
+)
+
+// TombstoneIndex holds a document for every resource that disappeared
+// between two incremental runs.
+const TombstoneIndex = "og_resource_tombstones"
+
+type IncrementalConfig struct {
+	// Enabled only sends the resources whose description changed since the
+	// previous run of the same integration and resource type, and tombstones
+	// for the ones that are gone.
+	Enabled bool
+	// Store keeps the description hashes of the previous runs, it is shared
+	// by the workers. Without it every resource is sent.
+	Store StateStore
+	// FullResync sends every resource, the hashes are refreshed as usual.
+	FullResync bool
+}
+
+func IncrementalConfigFromEnv() IncrementalConfig {
+	return IncrementalConfig{
+		Enabled: os.Getenv("DESCRIBE_INCREMENTAL") == "true",
+	}
+}
+
+// Tombstone marks a resource that was described by the previous run of its
+// integration and resource type but not by this one.
+type Tombstone struct {
+	EsID    string `json:"es_id"`
+	EsIndex string `json:"es_index"`
+
+	ResourceID      string           `json:"resource_id"`
+	ResourceType    string           `json:"resource_type"`
+	IntegrationType integration.Type `json:"integration_type"`
+	IntegrationID   string           `json:"integration_id"`
+	// ResourceEsIndex is the index the resource was stored in.
+	ResourceEsIndex string `json:"resource_es_index"`
+	DeletedAt       int64  `json:"deleted_at"`
+	DescribedBy     string `json:"described_by"`
+}
+
+func (t Tombstone) KeysAndIndex() ([]string, string) {
+	return []string{
+		t.ResourceID,
+		t.IntegrationID,
+		t.ResourceType,
+	}, TombstoneIndex
+}
+
+// describeState is what is kept between the incremental runs of an
+// integration and resource type.
+type describeState struct {
+	// Hashes are the description hashes of the last run, by resource id. They
+	// are kept in Shards, only states saved before those were added have them.
+	Hashes map[string]string `json:"hashes,omitempty"`
+	// Shards are the keys the hashes of the last run are split over, a single
+	// value can't hold them for the larger integrations.
+	Shards []string `json:"shards,omitempty"`
+	// Stale is set once runs overlapped, the one delivered last may not be
+	// the one the hashes are of. They then only tell which resources existed.
+	Stale bool `json:"stale,omitempty"`
+	// Claimed is set by a run while it is running.
+	Claimed   bool `json:"claimed,omitempty"`
+	ClaimedBy uint `json:"claimedBy,omitempty"`
+}
+
+// incrementalRun compares the resources of a job with the previous run.
+//
+// The state can only be trusted if no other run of the integration and
+// resource type was delivered in between, so a run claims the state when it
+// starts and saves it only if the claim still holds when it is done. A run
+// that finds the state claimed, by a run that is still going or one that
+// never finished, sends every resource. A run whose claim was taken over
+// marks the state stale, which makes the next run send every resource too.
+type incrementalRun struct {
+	cfg      IncrementalConfig
+	job      describe2.DescribeJob
+	key      string
+	revision uint64
+
+	// shards hold the previous hashes, they are dropped once replaced
+	shards []string
+
+	lock      sync.Mutex
+	previous  map[string]string
+	stale     bool
+	current   map[string]string
+	unchanged []string
+}
+
+const (
+	claimAttempts = 3
+	// maxStateShards bounds how many values the hashes of a run are split
+	// over.
+	maxStateShards = 256
+)
+
+// errStateShardMissing is returned when a shard of the state was dropped
+// while it was loaded, by a run that saved meanwhile.
+var errStateShardMissing = errors.New("describe state shard is missing")
+
+func newIncrementalRun(ctx context.Context, cfg IncrementalConfig, job describe2.DescribeJob, scope jobScope) (*incrementalRun, error) {
+	if cfg.Store == nil {
+		return nil, fmt.Errorf("no describe state store")
+	}
+	r := incrementalRun{
+		cfg:     cfg,
+		job:     job,
+		key:     stateKey(job, scope),
+		current: make(map[string]string),
+	}
+
+	for attempt := 0; ; attempt++ {
+		data, revision, err := cfg.Store.Load(ctx, r.key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load describe state: %w", err)
+		}
+		var state describeState
+		if data != nil {
+			if err := decodeState(data, &state); err != nil {
+				return nil, fmt.Errorf("failed to parse describe state: %w", err)
+			}
+		}
+		previous, err := loadStateShards(ctx, cfg.Store, state)
+		if errors.Is(err, errStateShardMissing) && attempt < claimAttempts {
+			// the next load finds the state that replaced it
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		claim := describeState{
+			Hashes:    state.Hashes,
+			Shards:    state.Shards,
+			Stale:     state.Stale || state.Claimed,
+			Claimed:   true,
+			ClaimedBy: job.JobID,
+		}
+		data, err = encodeState(claim)
+		if err != nil {
+			return nil, err
+		}
+		revision, err = cfg.Store.Update(ctx, r.key, data, revision)
+		if errors.Is(err, ErrStateConflict) && attempt < claimAttempts {
+			// another run claimed it meanwhile, the next load finds its claim
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim describe state: %w", err)
+		}
+
+		r.revision = revision
+		r.shards = state.Shards
+		r.previous = previous
+		r.stale = claim.Stale
+		return &r, nil
+	}
+}
+
+// loadStateShards gathers the hashes of the state from its shards.
+func loadStateShards(ctx context.Context, store StateStore, state describeState) (map[string]string, error) {
+	hashes := make(map[string]string, len(state.Hashes))
+	for id, hash := range state.Hashes {
+		hashes[id] = hash
+	}
+	for _, key := range state.Shards {
+		data, _, err := store.Load(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load describe state shard: %w", err)
+		}
+		if data == nil {
+			return nil, fmt.Errorf("%w: %s", errStateShardMissing, key)
+		}
+		var shard map[string]string
+		if err := decodeState(data, &shard); err != nil {
+			return nil, fmt.Errorf("failed to parse describe state shard: %w", err)
+		}
+		for id, hash := range shard {
+			hashes[id] = hash
+		}
+	}
+	return hashes, nil
+}
+
+// stateKey is the key of the state of the job's integration, scope and
+// resource type. Runs of different scopes describe different resources, a
+// run must not tombstone what is out of its scope.
+func stateKey(job describe2.DescribeJob, scope jobScope) string {
+	parts := []string{stateKeyToken(job.IntegrationID), stateKeyToken(scope.Scope)}
+	if scope.GroupID != "" {
+		parts = append(parts, stateKeyToken(scope.GroupID))
+	}
+	return strings.Join(append(parts, es.ResourceTypeToESIndex(job.ResourceType)), ".")
+}
+
+// stateKeyToken replaces what can't be part of a key value bucket key.
+func stateKeyToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '=':
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+// Stale reports whether every resource is sent because the previous state
+// can't be trusted.
+func (r *incrementalRun) Stale() bool {
+	return r.stale
+}
+
+// Changed records the hash of the resource and reports whether it has to be
+// sent.
+func (r *incrementalRun) Changed(resource *es.Resource) (bool, error) {
+	hash, err := contentHash(resource.Description)
+	if err != nil {
+		return false, err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.current[resource.ResourceID] = hash
+	if r.cfg.FullResync || r.stale || r.previous[resource.ResourceID] != hash {
+		return true, nil
+	}
+	r.unchanged = append(r.unchanged, resource.ResourceID)
+	return false, nil
+}
+
+// Unchanged lists the resources that were described but not sent.
+func (r *incrementalRun) Unchanged() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]string(nil), r.unchanged...)
+}
+
+// Tombstones returns a tombstone for every resource of the previous run that
+// was not described by this one.
+func (r *incrementalRun) Tombstones() []*Tombstone {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var tombstones []*Tombstone
+	for id := range r.previous {
+		if _, ok := r.current[id]; ok {
+			continue
+		}
+		tombstones = append(tombstones, newTombstone(r.job, id))
+	}
+	return tombstones
+}
+
+// newTombstone marks the resource of the job as deleted.
+func newTombstone(job describe2.DescribeJob, resourceID string) *Tombstone {
+	t := Tombstone{
+		ResourceID:      resourceID,
+		ResourceType:    strings.ToLower(job.ResourceType),
+		IntegrationType: configs.IntegrationName,
+		IntegrationID:   job.IntegrationID,
+		ResourceEsIndex: es.ResourceTypeToESIndex(job.ResourceType),
+		DeletedAt:       job.DescribedAt,
+		DescribedBy:     strconv.FormatUint(uint64(job.JobID), 10),
+	}
+	keys, idx := t.KeysAndIndex()
+	t.EsID = es.HashOf(keys...)
+	t.EsIndex = idx
+	return &t
+}
+
+// Save stores the hashes of this run for the next one. If keepMissing is set
+// the resources this run didn't see are kept, for runs that may have skipped
+// some. If another run claimed the state meanwhile the state is marked stale
+// instead and ErrStateConflict is returned.
+func (r *incrementalRun) Save(ctx context.Context, keepMissing bool) error {
+	r.lock.Lock()
+	state := describeState{Hashes: make(map[string]string, len(r.current))}
+	for id, hash := range r.current {
+		state.Hashes[id] = hash
+	}
+	if keepMissing {
+		for id, hash := range r.previous {
+			if _, ok := state.Hashes[id]; !ok {
+				state.Hashes[id] = hash
+			}
+		}
+	}
+	r.lock.Unlock()
+
+	shards, err := r.saveShards(ctx, state.Hashes)
+	if err != nil {
+		return err
+	}
+	data, err := encodeState(describeState{Shards: shards})
+	if err != nil {
+		return err
+	}
+	_, err = r.cfg.Store.Update(ctx, r.key, data, r.revision)
+	if err == nil {
+		r.dropShards(ctx, r.shards)
+		return nil
+	}
+	if !errors.Is(err, ErrStateConflict) {
+		r.dropShards(ctx, shards)
+		return err
+	}
+
+	// the other run may be delivered before or after this one
+	var replaced describeState
+	if current, _, err := r.cfg.Store.Load(ctx, r.key); err == nil && current != nil {
+		_ = decodeState(current, &replaced)
+	}
+	if data, err = encodeState(describeState{Shards: shards, Stale: true}); err != nil {
+		return err
+	}
+	if err := r.cfg.Store.Put(ctx, r.key, data); err != nil {
+		r.dropShards(ctx, shards)
+		return fmt.Errorf("failed to mark describe state stale: %w", err)
+	}
+	r.dropShards(ctx, replaced.Shards)
+	return ErrStateConflict
+}
+
+// saveShards splits the hashes over as few values as the store takes and
+// returns their keys. The keys are new for every run, the state still points
+// at the previous ones until it is updated.
+func (r *incrementalRun) saveShards(ctx context.Context, hashes map[string]string) ([]string, error) {
+	limit := r.cfg.Store.MaxValueSize()
+	for n := 1; n <= maxStateShards; n *= 2 {
+		values, ok, err := encodeShards(hashes, n, limit)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		keys := make([]string, 0, len(values))
+		for i, value := range values {
+			key := fmt.Sprintf("%s.shard_%d_%d", r.key, r.revision, i)
+			if err := r.cfg.Store.Put(ctx, key, value); err != nil {
+				r.dropShards(ctx, keys)
+				return nil, fmt.Errorf("failed to save describe state shard: %w", err)
+			}
+			keys = append(keys, key)
+		}
+		return keys, nil
+	}
+	return nil, fmt.Errorf("describe state of %d resources doesn't fit in %d values of %d bytes", len(hashes), maxStateShards, limit)
+}
+
+// encodeShards splits the hashes over n values by the hash of the resource
+// ids. It reports false if one of them is larger than limit.
+func encodeShards(hashes map[string]string, n, limit int) ([][]byte, bool, error) {
+	shards := make([]map[string]string, n)
+	for i := range shards {
+		shards[i] = make(map[string]string)
+	}
+	for id, hash := range hashes {
+		h := fnv.New32a()
+		h.Write([]byte(id))
+		shards[h.Sum32()%uint32(n)][id] = hash
+	}
+
+	values := make([][]byte, 0, n)
+	for _, shard := range shards {
+		if len(shard) == 0 && len(hashes) > 0 {
+			continue
+		}
+		value, err := encodeState(shard)
+		if err != nil {
+			return nil, false, err
+		}
+		if limit > 0 && len(value) > limit {
+			return nil, false, nil
+		}
+		values = append(values, value)
+	}
+	return values, true, nil
+}
+
+// dropShards deletes shards that no state points at anymore. A shard left
+// behind only takes space, failing to delete it is not an error.
+func (r *incrementalRun) dropShards(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		_ = r.cfg.Store.Delete(ctx, key)
+	}
+}
+
+// encodeState compresses the state, the ids of the resources have a lot in
+// common.
+func encodeState(state any) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(state); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeState(data []byte, state any) error {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	return json.NewDecoder(zr).Decode(state)
+}
+
+// contentHash is the hash of a trimmed description, json.Marshal sorts the
+// keys of maps so it is stable across runs. Half of the sha256 is plenty to
+// tell two descriptions of a resource apart and keeps the state small.
+func contentHash(description any) (string, error) {
+	data, err := json.Marshal(description)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:16]), nil
+}
@@ -0,0 +1,249 @@
+package describer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	describe2 "github.com/opengovern/og-util/pkg/describe"
+	"github.com/opengovern/og-util/pkg/es"
+)
+
+// memStateStore is a StateStore that keeps the state in memory.
+type memStateStore struct {
+	lock      sync.Mutex
+	values    map[string][]byte
+	revisions map[string]uint64
+	seq       uint64
+	maxValue  int
+}
+
+func newMemStateStore() *memStateStore {
+	return &memStateStore{values: map[string][]byte{}, revisions: map[string]uint64{}}
+}
+
+func (s *memStateStore) Load(_ context.Context, key string) ([]byte, uint64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.values[key], s.revisions[key], nil
+}
+
+func (s *memStateStore) Update(_ context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.revisions[key] != revision {
+		return 0, ErrStateConflict
+	}
+	s.seq++
+	s.values[key], s.revisions[key] = value, s.seq
+	return s.seq, nil
+}
+
+func (s *memStateStore) Put(_ context.Context, key string, value []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.seq++
+	s.values[key], s.revisions[key] = value, s.seq
+	return nil
+}
+
+func (s *memStateStore) Delete(_ context.Context, key string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.values, key)
+	delete(s.revisions, key)
+	return nil
+}
+
+func (s *memStateStore) MaxValueSize() int {
+	return s.maxValue
+}
+
+func TestIncrementalRun(t *testing.T) {
+	ctx := context.Background()
+	cfg := IncrementalConfig{Enabled: true, Store: newMemStateStore()}
+	job := describe2.DescribeJob{IntegrationID: "sub", ResourceType: "Microsoft.Compute/disks"}
+	resource := func(id, size string) *es.Resource {
+		return &es.Resource{ResourceID: id, Description: map[string]any{"size": size}}
+	}
+
+	first, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []*es.Resource{resource("a", "1"), resource("b", "1")} {
+		if changed, _ := first.Changed(r); !changed {
+			t.Errorf("resource %s of the first run is unchanged", r.ResourceID)
+		}
+	}
+	if err := first.Save(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed, _ := second.Changed(resource("a", "1")); changed {
+		t.Error("unchanged resource a is reported as changed")
+	}
+	if changed, _ := second.Changed(resource("c", "1")); !changed {
+		t.Error("new resource c is reported as unchanged")
+	}
+	tombstones := second.Tombstones()
+	if len(tombstones) != 1 || tombstones[0].ResourceID != "b" {
+		t.Fatalf("Tombstones() = %v, want a tombstone for b", tombstones)
+	}
+	if unchanged := second.Unchanged(); len(unchanged) != 1 || unchanged[0] != "a" {
+		t.Errorf("Unchanged() = %v, want [a]", unchanged)
+	}
+	if err := second.Save(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.FullResync = true
+	third, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed, _ := third.Changed(resource("a", "1")); !changed {
+		t.Error("full resync skipped an unchanged resource")
+	}
+}
+
+func TestIncrementalRunOverlap(t *testing.T) {
+	ctx := context.Background()
+	cfg := IncrementalConfig{Enabled: true, Store: newMemStateStore()}
+	job := describe2.DescribeJob{IntegrationID: "sub", ResourceType: "Microsoft.Compute/disks"}
+	a := &es.Resource{ResourceID: "a", Description: map[string]any{"size": "1"}}
+
+	run, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	run.Changed(a)
+	if err := run.Save(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// a run that starts while another one is going can't trust the state
+	slow, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fast, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed, _ := fast.Changed(a); !changed || !fast.Stale() {
+		t.Error("overlapping run skipped an unchanged resource")
+	}
+	if err := fast.Save(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// the run that finishes last may be delivered last, the state is stale
+	slow.Changed(a)
+	if err := slow.Save(ctx, false); !errors.Is(err, ErrStateConflict) {
+		t.Fatalf("Save() = %v, want a conflict", err)
+	}
+	next, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed, _ := next.Changed(a); !changed {
+		t.Error("run after overlapping ones skipped an unchanged resource")
+	}
+
+	// a run that never finished leaves its claim behind
+	if _, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription}); err != nil {
+		t.Fatal(err)
+	}
+	if run, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription}); err != nil || !run.Stale() {
+		t.Fatalf("got %v, %v after an unfinished run", run, err)
+	}
+}
+
+func TestIncrementalRunScopes(t *testing.T) {
+	ctx := context.Background()
+	cfg := IncrementalConfig{Enabled: true, Store: newMemStateStore()}
+	job := describe2.DescribeJob{IntegrationID: "sub", ResourceType: "Microsoft.Compute/disks"}
+
+	tenant, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeTenant})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"/subscriptions/sub1/a", "/subscriptions/sub2/b"} {
+		tenant.Changed(&es.Resource{ResourceID: id, Description: map[string]any{}})
+	}
+	if err := tenant.Save(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// a subscription scoped run has a state of its own
+	subscription, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	subscription.Changed(&es.Resource{ResourceID: "/subscriptions/sub1/a", Description: map[string]any{}})
+	if tombstones := subscription.Tombstones(); len(tombstones) != 0 {
+		t.Fatalf("Tombstones() = %v across scopes", tombstones)
+	}
+
+	if a, b := stateKey(job, jobScope{Scope: ScopeManagementGroup, GroupID: "group-1"}), stateKey(job, jobScope{Scope: ScopeManagementGroup, GroupID: "group-2"}); a == b {
+		t.Errorf("management groups share the state key %s", a)
+	}
+}
+
+func TestIncrementalRunShards(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStateStore()
+	store.maxValue = 1024
+	cfg := IncrementalConfig{Enabled: true, Store: store}
+	job := describe2.DescribeJob{IntegrationID: "sub", ResourceType: "Microsoft.Compute/disks"}
+	resource := func(i int) *es.Resource {
+		return &es.Resource{ResourceID: fmt.Sprintf("/subscriptions/sub/disks/disk-%d", i), Description: map[string]any{"i": i}}
+	}
+
+	first, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 500; i++ {
+		first.Changed(resource(i))
+	}
+	if err := first.Save(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	firstKeys := len(store.values)
+	if firstKeys < 3 {
+		t.Fatalf("state saved in %d values, want it split", firstKeys)
+	}
+	for key, value := range store.values {
+		if len(value) > store.maxValue {
+			t.Errorf("value of %s is %d bytes, over %d", key, len(value), store.maxValue)
+		}
+	}
+
+	second, err := newIncrementalRun(ctx, cfg, job, jobScope{Scope: ScopeSubscription})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < 500; i++ {
+		if changed, _ := second.Changed(resource(i)); changed {
+			t.Fatalf("Changed(%d) = true for an unchanged resource", i)
+		}
+	}
+	if tombstones := second.Tombstones(); len(tombstones) != 1 {
+		t.Fatalf("got %d tombstones, want 1", len(tombstones))
+	}
+	if err := second.Save(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	// the shards of the first run are dropped
+	if len(store.values) > firstKeys {
+		t.Errorf("%d values after the second run, %d after the first", len(store.values), firstKeys)
+	}
+}
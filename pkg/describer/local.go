@@ -0,0 +1,75 @@
+package describer
+
+import (
+	"context"
+	"fmt"
+	model "github.com/opengovern/og-describer-azure/pkg/sdk/models"
+	"github.com/opengovern/og-describer-azure/provider"
+	"github.com/opengovern/og-describer-azure/provider/configs"
+	"github.com/opengovern/og-describer-azure/steampipe"
+	describe2 "github.com/opengovern/og-util/pkg/describe"
+	"github.com/opengovern/og-util/pkg/es"
+	"go.uber.org/zap"
+)
+
+// DescribeLocal runs the describer of job.ResourceType in-process and hands
+// every resource, followed by its lookup document, straight to sink.
+// Nothing goes through the job queue or the vault.
+func DescribeLocal(
+	ctx context.Context,
+	logger *zap.Logger,
+	job describe2.DescribeJob,
+	creds configs.IntegrationCredentials,
+	sink Sink) ([]string, error) {
+	resourceType, err := GetResourceType(job.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+	job.ResourceType = resourceType.ResourceName
+
+	plg := steampipe.Plugin()
+	redactor, err := descriptionRedactor(job.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	var resourceIDs []string
+	f := func(resource model.Resource) error {
+		r, err := buildResource(logger, plg, job, resource, redactor)
+		if err != nil {
+			return err
+		}
+		if r == nil {
+			return nil
+		}
+
+		keys, idx := r.KeysAndIndex()
+		r.EsID = es.HashOf(keys...)
+		r.EsIndex = idx
+		if err := sink.Ingest(ctx, []es.Doc{r, lookupResourceOf(r)}); err != nil {
+			return fmt.Errorf("failed to write resource: %w", err)
+		}
+		resourceIDs = append(resourceIDs, r.ResourceID)
+		return nil
+	}
+	clientStream := (*model.StreamSender)(&f)
+
+	additionalParameters, err := provider.GetAdditionalParameters(job)
+	if err != nil {
+		return nil, err
+	}
+	err = GetResources(
+		ctx,
+		logger,
+		job.ResourceType,
+		job.TriggerType,
+		creds,
+		additionalParameters,
+		clientStream,
+	)
+	if err != nil {
+		return resourceIDs, err
+	}
+
+	return resourceIDs, partialResult(ctx)
+}
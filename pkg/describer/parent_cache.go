@@ -0,0 +1,14 @@
+package describer
+
+import (
+	"context"
+
+	"github.com/opengovern/og-describer-azure/provider/describer"
+)
+
+// withParentCache gives the job a parent cache of its own. The listings are
+// only shared by the describers of the job, the next job lists the parents
+// again and sees the ones created meanwhile.
+func withParentCache(ctx context.Context) context.Context {
+	return describer.WithParentCache(ctx, describer.NewParentCache())
+}
@@ -0,0 +1,57 @@
+package describer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/opengovern/og-describer-azure/provider"
+	"github.com/opengovern/og-describer-azure/provider/describer"
+)
+
+const ErrCodePartialSuccess = "PartialSuccess"
+
+// PartialError is returned by a job that ran in partial success mode and
+// skipped, or only partly described, some resources.
+type PartialError struct {
+	Failures []describer.Failure
+}
+
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("%d resources were not fully described", len(e.Failures))
+}
+
+// Report is the failure list as delivered to the control plane.
+func (e *PartialError) Report() string {
+	report, err := json.Marshal(e.Failures)
+	if err != nil {
+		return e.Error()
+	}
+	return string(report)
+}
+
+// partialSuccessEnabled reads the partialSuccess job input, falling back to
+// DESCRIBE_PARTIAL_SUCCESS.
+func partialSuccessEnabled(extraInputs map[string][]string) bool {
+	v := os.Getenv("DESCRIBE_PARTIAL_SUCCESS")
+	if in, ok := extraInputs["partialSuccess"]; ok && len(in) > 0 {
+		v = in[0]
+	}
+	enabled, _ := strconv.ParseBool(v)
+	return enabled
+}
+
+// partialResult returns a PartialError if the describers of the job
+// reported failures.
+func partialResult(ctx context.Context) error {
+	failures := describer.GetFailuresFromContext(ctx).List()
+	if len(failures) == 0 {
+		return nil
+	}
+	for i := range failures {
+		failures[i].ErrorCode = provider.ClassifyError(failures[i].Err)
+	}
+	return &PartialError{Failures: failures}
+}
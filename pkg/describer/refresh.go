@@ -0,0 +1,83 @@
+package describer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	model "github.com/opengovern/og-describer-azure/pkg/sdk/models"
+	"github.com/opengovern/og-describer-azure/pkg/tracing"
+	"github.com/opengovern/og-describer-azure/provider/configs"
+	"github.com/opengovern/og-describer-azure/provider/describer"
+	describe2 "github.com/opengovern/og-util/pkg/describe"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var (
+	refreshResourceKey string = "refresh_resource"
+)
+
+// refreshResourceOf reads the resourceId job input. A job with one refreshes
+// that resource instead of describing its resource type, e.g. after a
+// remediation.
+func refreshResourceOf(extraInputs map[string][]string) string {
+	if in, ok := extraInputs["resourceId"]; ok && len(in) > 0 {
+		return in[0]
+	}
+	return ""
+}
+
+func withRefreshResource(ctx context.Context, resourceID string) context.Context {
+	return context.WithValue(ctx, refreshResourceKey, resourceID)
+}
+
+func getRefreshResourceFromContext(ctx context.Context) string {
+	resourceID, _ := ctx.Value(refreshResourceKey).(string)
+	return resourceID
+}
+
+// refreshResource describes the resource with the get describer of the job's
+// resource type and streams it. A resource that Azure reports gone gets a
+// tombstone, one that wasn't described fails the job.
+func refreshResource(
+	ctx context.Context,
+	logger *zap.Logger,
+	job describe2.DescribeJob,
+	cfg configs.IntegrationCredentials,
+	additionalData map[string]string,
+	resourceID string,
+	stream *model.StreamSender,
+	rs *ResourceSender,
+) error {
+	resourceType, err := GetResourceType(job.ResourceType)
+	if err != nil {
+		return Permanent(err)
+	}
+	if resourceType.GetDescriber == nil {
+		return Permanent(fmt.Errorf("resource type %s can't be refreshed", job.ResourceType))
+	}
+	additionalData["resourceId"] = resourceID
+	ctx = describer.WithLogger(ctx, logger)
+
+	ctx, span := tracing.Tracer().Start(ctx, "GetDescriber", trace.WithAttributes(
+		attribute.String("job.resource_type", job.ResourceType),
+		attribute.String("job.resource_id", resourceID),
+	))
+	resource, err := resourceType.GetDescriber(ctx, cfg, job.TriggerType, additionalData)
+	tracing.End(span, err)
+	if errors.Is(err, describer.ErrResourceNotFound) {
+		logger.Info("refreshed resource is gone", zap.String("resourceID", resourceID))
+		rs.SendDoc(newTombstone(job, resourceID))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if resource == nil {
+		// skipped in partial success mode, or not listed
+		return fmt.Errorf("resource %s was not described", resourceID)
+	}
+	return (*stream)(*resource)
+}
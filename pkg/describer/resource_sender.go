@@ -2,24 +2,15 @@ package describer
 
 import (
 	"context"
-	"crypto/tls"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/opengovern/og-describer-azure/provider/configs"
+	"github.com/opengovern/og-describer-azure/pkg/metrics"
+	"github.com/opengovern/og-describer-azure/pkg/tracing"
+	"github.com/opengovern/og-describer-azure/provider/describer"
 	"github.com/opengovern/og-util/pkg/es"
-	"github.com/opengovern/og-util/proto/src/golang"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
-	"golang.org/x/oauth2"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/credentials/oauth"
-	"google.golang.org/grpc/metadata"
-	"google.golang.org/protobuf/types/known/anypb"
-	"io"
-	"net/http"
-	"strings"
 	"time"
 )
 
@@ -30,72 +21,75 @@ const (
 	BufferEmptyRate time.Duration = 5 * time.Second
 )
 
-type ResourceSender struct {
-	authToken                 string
-	logger                    *zap.Logger
-	resourceChannel           chan *es.Resource
-	resourceIDs               []string
-	doneChannel               chan interface{}
-	conn                      *grpc.ClientConn
-	grpcEndpoint              string
-	ingestionPipelineEndpoint string
-	jobID                     uint
+const (
+	ErrCodeDeliveryFailed  = "DeliveryFailed"
+	ErrCodePartialDelivery = "PartialDelivery"
+)
 
-	client     golang.EsSinkServiceClient
-	httpClient *http.Client
+// DeliveryError lists the described resources that were still undelivered
+// when the sender finished, out of the Total described.
+type DeliveryError struct {
+	Undelivered []string
+	Total       int
+}
 
-	sendBuffer    []*es.Resource
-	useOpenSearch bool
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("failed to deliver %d of %d resources", len(e.Undelivered), e.Total)
 }
 
-func NewResourceSender(grpcEndpoint, ingestionPipelineEndpoint string, describeToken string, jobID uint, useOpenSearch bool, logger *zap.Logger) (*ResourceSender, error) {
-	rs := ResourceSender{
-		authToken:                 describeToken,
-		logger:                    logger,
-		resourceChannel:           make(chan *es.Resource, ChannelSize),
-		resourceIDs:               nil,
-		doneChannel:               make(chan interface{}),
-		conn:                      nil,
-		grpcEndpoint:              grpcEndpoint,
-		ingestionPipelineEndpoint: ingestionPipelineEndpoint,
-		jobID:                     jobID,
-		useOpenSearch:             useOpenSearch,
+type ResourceSender struct {
+	logger          *zap.Logger
+	resourceChannel chan es.Doc
+	resourceIDs     []string
+	doneChannel     chan interface{}
+	jobID           uint
 
-		httpClient: &http.Client{Timeout: 10 * time.Second},
-	}
-	if err := rs.Connect(); err != nil {
-		return nil, err
-	}
+	ctx      context.Context
+	sink     Sink
+	sinkType SinkType
+	progress *describer.Progress
 
-	go rs.ResourceHandler()
-	return &rs, nil
-}
-
-func (s *ResourceSender) Connect() error {
-	var opts []grpc.DialOption
-	if s.authToken != "" {
-		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})))
-		opts = append(opts, grpc.WithPerRPCCredentials(oauth.TokenSource{
-			TokenSource: oauth2.StaticTokenSource(&oauth2.Token{
-				AccessToken: s.authToken,
-			}),
-		}))
-	} else {
-		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	spool         *Spool
+	spoolCfg      SpoolConfig
+	batchCfg      BatchConfig
+	retryAttempts int
+	nextRetry     time.Time
+	described     map[string]struct{}
+	undelivered   map[string]struct{}
+	// undeliveredDocs counts the other documents, such as tombstones, that
+	// were not delivered.
+	undeliveredDocs int
+
+	sendBuffer      []es.Doc
+	sendBufferCount int
+	sendBufferBytes int
+}
+
+func NewResourceSender(ctx context.Context, sink Sink, cfg SinkConfig, jobID uint, logger *zap.Logger) *ResourceSender {
+	rs := ResourceSender{
+		logger:          logger,
+		resourceChannel: make(chan es.Doc, ChannelSize),
+		resourceIDs:     nil,
+		described:       make(map[string]struct{}),
+		doneChannel:     make(chan interface{}),
+		jobID:           jobID,
+		ctx:             ctx,
+		sink:            sink,
+		sinkType:        cfg.Type,
+		progress:        describer.GetProgressFromContext(ctx),
+		spoolCfg:        cfg.Spool,
+		batchCfg:        cfg.Batch,
 	}
 
-	conn, err := grpc.NewClient(
-		s.grpcEndpoint,
-		opts...,
-	)
+	spool, err := NewSpool(cfg.Spool.Dir, jobID)
 	if err != nil {
-		return err
+		logger.Error("failed to create spool, failed batches will be dropped", zap.Error(err))
+	} else {
+		rs.spool = spool
 	}
-	s.conn = conn
 
-	client := golang.NewEsSinkServiceClient(conn)
-	s.client = client
-	return nil
+	go rs.ResourceHandler()
+	return &rs
 }
 
 func (s *ResourceSender) ResourceHandler() {
@@ -104,51 +98,235 @@ func (s *ResourceSender) ResourceHandler() {
 
 	for {
 		select {
-		case resource := <-s.resourceChannel:
-			if resource == nil {
+		case doc := <-s.resourceChannel:
+			if doc == nil {
 				s.flushBuffer(true)
+				s.drainSpool()
 				s.doneChannel <- struct{}{}
 				return
 			}
 
-			s.resourceIDs = append(s.resourceIDs, resource.ResourceID)
-			s.sendBuffer = append(s.sendBuffer, resource)
+			if resource, ok := doc.(*es.Resource); ok {
+				s.resourceIDs = append(s.resourceIDs, resource.ResourceID)
+				s.described[resource.ResourceID] = struct{}{}
+				s.buffer(resource)
+			} else {
+				s.bufferDoc(doc)
+			}
 
-			if len(s.sendBuffer) > MaxBufferSize {
+			if s.sendBufferCount >= s.batchCfg.MaxDocs || s.sendBufferBytes >= s.batchCfg.MaxBytes {
 				s.flushBuffer(true)
 			}
 		case <-t.C:
 			s.flushBuffer(false)
+			if s.spool != nil && time.Now().After(s.nextRetry) {
+				s.retrySpool()
+			}
 		}
 	}
 }
 
 func (s *ResourceSender) sendToBackend(resourcesToSend []es.Doc) {
-	grpcCtx := metadata.NewOutgoingContext(context.Background(), metadata.New(map[string]string{
-		"resource-job-id": fmt.Sprintf("%d", s.jobID),
-	}))
+	failed := s.ingest(resourcesToSend)
+	if len(failed) == 0 {
+		return
+	}
+	if s.spool == nil {
+		s.markUndelivered(failed)
+		return
+	}
+	if err := s.spool.Put(failed); err != nil {
+		s.logger.Error("failed to spool resources", zap.Error(err), zap.Uint("jobID", s.jobID))
+		s.markUndelivered(failed)
+		return
+	}
+	if s.nextRetry.IsZero() {
+		s.nextRetry = time.Now().Add(s.spoolCfg.Backoff(0))
+	}
+}
+
+// ingest sends docs to the sink and returns the documents that were not
+// accepted and may be retried.
+func (s *ResourceSender) ingest(docs []es.Doc) []es.Doc {
+	_, span := tracing.Tracer().Start(s.ctx, "ResourceSender.ingest", trace.WithAttributes(
+		attribute.String("sink", string(s.sinkType)),
+		attribute.Int("docs", len(docs)),
+	))
+	// the batch is sent even if the job is cancelled
+	err := s.sink.Ingest(trace.ContextWithSpan(context.Background(), span), docs)
+	tracing.End(span, err)
+	s.progress.Touch()
+	s.observeIngest(docs, err)
+	if err == nil {
+		return nil
+	}
+
+	if IsPermanent(err) {
+		// retrying won't get these through, the sink rejected the request itself
+		s.logger.Error("sink rejected the batch", zap.Error(err), zap.Uint("jobID", s.jobID), zap.Int("docs", len(docs)))
+		s.markUndelivered(docs)
+		return nil
+	}
+
+	var ingestErr *IngestError
+	if !errors.As(err, &ingestErr) {
+		s.logger.Error("failed to send resource", zap.Error(err), zap.Uint("jobID", s.jobID))
+		return docs
+	}
 
-	docs := make([]*anypb.Any, 0, len(resourcesToSend))
-	for _, resource := range resourcesToSend {
-		docBytes, err := json.Marshal(resource)
+	failedIDs := make(map[string]struct{}, len(ingestErr.Failures))
+	for _, f := range ingestErr.Failures {
+		s.logger.Error("failed to send resource", zap.Uint("jobID", s.jobID),
+			zap.String("resourceID", f.ResourceID), zap.String("index", f.Index),
+			zap.Int("status", f.Status), zap.String("reason", f.Reason))
+		failedIDs[f.ID] = struct{}{}
+	}
+	var failed []es.Doc
+	for _, doc := range docs {
+		keys, _ := doc.KeysAndIndex()
+		if _, ok := failedIDs[es.HashOf(keys...)]; ok {
+			failed = append(failed, doc)
+		}
+	}
+	return failed
+}
+
+func (s *ResourceSender) observeIngest(docs []es.Doc, err error) {
+	bytes := 0
+	for _, doc := range docs {
+		bytes += docSize(doc)
+	}
+	metrics.IngestBatches.WithLabelValues(string(s.sinkType)).Inc()
+	metrics.IngestBytes.WithLabelValues(string(s.sinkType)).Add(float64(bytes))
+	if err != nil {
+		metrics.IngestErrors.WithLabelValues(string(s.sinkType)).Inc()
+	}
+}
+
+// retrySpool resends the spooled batches, oldest first, and stops at the
+// first batch that still fails.
+func (s *ResourceSender) retrySpool() {
+	batches, err := s.spool.Batches()
+	if err != nil {
+		s.logger.Error("failed to list spool", zap.Error(err), zap.Uint("jobID", s.jobID))
+		return
+	}
+	for _, batch := range batches {
+		docs, err := s.spool.Load(batch)
 		if err != nil {
-			s.logger.Error("failed to marshal resource", zap.Error(err))
+			s.logger.Error("failed to load spooled batch", zap.Error(err), zap.String("batch", batch))
 			continue
 		}
-		docs = append(docs, &anypb.Any{Value: docBytes})
+
+		failed := s.ingest(docs)
+		if err := s.spool.Replace(batch, failed); err != nil {
+			s.logger.Error("failed to update spooled batch", zap.Error(err), zap.String("batch", batch))
+		}
+		if len(failed) > 0 {
+			s.retryAttempts++
+			s.nextRetry = time.Now().Add(s.spoolCfg.Backoff(s.retryAttempts))
+			s.logger.Warn("spooled batch still failing", zap.Uint("jobID", s.jobID),
+				zap.Int("attempt", s.retryAttempts), zap.Time("nextRetry", s.nextRetry))
+			return
+		}
 	}
+	s.retryAttempts = 0
+	s.nextRetry = time.Time{}
+}
 
-	_, err := s.client.Ingest(grpcCtx, &golang.IngestRequest{Docs: docs})
-	if err != nil {
-		s.logger.Error("failed to send resource", zap.Error(err))
-		if errors.Is(err, io.EOF) {
-			err = s.Connect()
-			if err != nil {
-				s.logger.Error("failed to reconnect", zap.Error(err))
+// drainSpool keeps retrying the spool until it is empty, the retry attempts
+// are exhausted or the drain timeout passes. It goes on when the job is
+// cancelled, so a worker shutting down still delivers what it spooled.
+// Whatever is left is recorded as undelivered.
+func (s *ResourceSender) drainSpool() {
+	if s.spool == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(s.ctx), s.spoolCfg.DrainTimeout)
+	defer cancel()
+	defer func() {
+		if err := s.spool.Close(); err != nil {
+			s.logger.Error("failed to remove spool", zap.Error(err))
+		}
+	}()
+
+	for {
+		batches, err := s.spool.Batches()
+		if err != nil {
+			s.logger.Error("failed to list spool", zap.Error(err), zap.Uint("jobID", s.jobID))
+			return
+		}
+		if len(batches) == 0 {
+			return
+		}
+		if s.retryAttempts >= s.spoolCfg.MaxAttempts || ctx.Err() != nil {
+			for _, batch := range batches {
+				docs, err := s.spool.Load(batch)
+				if err != nil {
+					s.logger.Error("failed to load spooled batch", zap.Error(err), zap.String("batch", batch))
+					continue
+				}
+				s.markUndelivered(docs)
 			}
+			return
+		}
+		wait := time.NewTimer(time.Until(s.nextRetry))
+		select {
+		case <-wait.C:
+			s.retrySpool()
+		case <-ctx.Done():
+			wait.Stop()
+		}
+	}
+}
+
+func (s *ResourceSender) markUndelivered(docs []es.Doc) {
+	if s.undelivered == nil {
+		s.undelivered = make(map[string]struct{})
+	}
+	for _, doc := range docs {
+		id := resourceIDOf(doc)
+		if _, ok := s.described[id]; ok {
+			s.undelivered[id] = struct{}{}
+		} else {
+			s.undeliveredDocs++
+		}
+	}
+}
+
+// buffer serializes the resource and its lookup document once, so batches
+// can be sized by bytes and the sinks don't marshal them again.
+func (s *ResourceSender) buffer(resource *es.Resource) {
+	keys, idx := resource.KeysAndIndex()
+	resource.EsID = es.HashOf(keys...)
+	resource.EsIndex = idx
+
+	for _, doc := range []es.Doc{resource, lookupResourceOf(resource)} {
+		ed, err := encodeDoc(doc)
+		if err != nil {
+			s.logger.Error("failed to marshal resource", zap.Error(err), zap.String("resourceID", resource.ResourceID))
+			s.markUndelivered([]es.Doc{doc})
+			continue
 		}
+		if len(ed.Doc) > s.batchCfg.MaxBytes {
+			s.logger.Warn("resource is larger than the batch size limit", zap.String("resourceID", resource.ResourceID), zap.Int("bytes", len(ed.Doc)))
+		}
+		s.sendBuffer = append(s.sendBuffer, ed)
+		s.sendBufferBytes += len(ed.Doc)
+	}
+	s.sendBufferCount++
+}
+
+// bufferDoc buffers a document that is not a resource, such as a tombstone.
+func (s *ResourceSender) bufferDoc(doc es.Doc) {
+	ed, err := encodeDoc(doc)
+	if err != nil {
+		s.logger.Error("failed to marshal document", zap.Error(err), zap.String("resourceID", resourceIDOf(doc)))
 		return
 	}
+	s.sendBuffer = append(s.sendBuffer, ed)
+	s.sendBufferBytes += len(ed.Doc)
+	s.sendBufferCount++
 }
 
 func (s *ResourceSender) flushBuffer(force bool) {
@@ -156,51 +334,67 @@ func (s *ResourceSender) flushBuffer(force bool) {
 		return
 	}
 
-	if !force && len(s.sendBuffer) < MinBufferSize {
+	if !force && s.sendBufferCount < s.batchCfg.MinDocs {
 		return
 	}
 
-	resourcesToSend := make([]es.Doc, 0, 2*len(s.sendBuffer))
-
-	for _, resource := range s.sendBuffer {
-		kafkaResource := resource
-		keys, idx := kafkaResource.KeysAndIndex()
-		kafkaResource.EsID = es.HashOf(keys...)
-		kafkaResource.EsIndex = idx
-
-		lookupResource := es.LookupResource{
-			PlatformID:      resource.PlatformID,
-			ResourceID:      resource.ResourceID,
-			ResourceName:    resource.ResourceName,
-			IntegrationType: configs.IntegrationName,
-			ResourceType:    strings.ToLower(resource.ResourceType),
-			IntegrationID:   resource.IntegrationID,
-			DescribedBy:     resource.DescribedBy,
-			DescribedAt:     resource.DescribedAt,
-			Tags:            resource.CanonicalTags,
-		}
-		lookupKeys, lookupIdx := lookupResource.KeysAndIndex()
-		lookupResource.EsID = es.HashOf(lookupKeys...)
-		lookupResource.EsIndex = lookupIdx
-
-		resourcesToSend = append(resourcesToSend, kafkaResource)
-		resourcesToSend = append(resourcesToSend, lookupResource)
+	for _, batch := range splitBatch(s.sendBuffer, 2*s.batchCfg.MaxDocs, s.batchCfg.MaxBytes) {
+		s.sendToBackend(batch)
 	}
-
-	s.sendToBackend(resourcesToSend)
 	s.sendBuffer = nil
+	s.sendBufferCount = 0
+	s.sendBufferBytes = 0
 }
 
-func (s *ResourceSender) Finish() {
+// Finish flushes the buffer, retries the spool and closes the sink. It
+// returns a DeliveryError if some described resources could not be
+// delivered, the other documents are only counted by UndeliveredDocs.
+func (s *ResourceSender) Finish() error {
 	s.resourceChannel <- nil
 	_ = <-s.doneChannel
-	s.conn.Close()
+	if err := s.sink.Close(); err != nil {
+		s.logger.Error("failed to close sink", zap.Error(err))
+	}
+
+	if s.undeliveredDocs > 0 {
+		s.logger.Warn("documents were not delivered", zap.Uint("jobID", s.jobID), zap.Int("count", s.undeliveredDocs))
+	}
+	if len(s.undelivered) == 0 {
+		return nil
+	}
+	undelivered := make([]string, 0, len(s.undelivered))
+	for id := range s.undelivered {
+		undelivered = append(undelivered, id)
+	}
+	return &DeliveryError{Undelivered: undelivered, Total: len(s.resourceIDs)}
+}
+
+// UndeliveredDocs is the number of documents other than the described
+// resources, such as tombstones, that were not delivered.
+func (s *ResourceSender) UndeliveredDocs() int {
+	return s.undeliveredDocs
 }
 
+// GetResourceIDs returns the ids of the resources that were delivered.
 func (s *ResourceSender) GetResourceIDs() []string {
-	return s.resourceIDs
+	if len(s.undelivered) == 0 {
+		return s.resourceIDs
+	}
+	delivered := make([]string, 0, len(s.resourceIDs))
+	for _, id := range s.resourceIDs {
+		if _, ok := s.undelivered[id]; !ok {
+			delivered = append(delivered, id)
+		}
+	}
+	return delivered
 }
 
 func (s *ResourceSender) Send(resource *es.Resource) {
 	s.resourceChannel <- resource
 }
+
+// SendDoc sends a document that is not a resource, it is not counted in
+// the resource ids of the job.
+func (s *ResourceSender) SendDoc(doc es.Doc) {
+	s.resourceChannel <- doc
+}
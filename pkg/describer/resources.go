@@ -6,10 +6,13 @@ import (
 	"context"
 	"fmt"
 	model "github.com/opengovern/og-describer-azure/pkg/sdk/models"
+	"github.com/opengovern/og-describer-azure/pkg/tracing"
 	"github.com/opengovern/og-describer-azure/provider"
 	"github.com/opengovern/og-describer-azure/provider/configs"
 	"github.com/opengovern/og-describer-azure/provider/describer"
 	"github.com/opengovern/og-util/pkg/describe/enums"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"sort"
 	"strings"
@@ -67,5 +70,10 @@ func describe(ctx context.Context, logger *zap.Logger, accountCfg configs.Integr
 	}
 	ctx = describer.WithLogger(ctx, logger)
 
-	return resourceTypeObject.ListDescriber(ctx, accountCfg, triggerType, additionalData, stream)
+	ctx, span := tracing.Tracer().Start(ctx, "ListDescriber", trace.WithAttributes(
+		attribute.String("job.resource_type", resourceType),
+	))
+	resources, err := resourceTypeObject.ListDescriber(ctx, accountCfg, triggerType, additionalData, stream)
+	tracing.End(span, err)
+	return resources, err
 }
@@ -0,0 +1,87 @@
+package describer
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/opengovern/og-describer-azure/provider/describer"
+	describe2 "github.com/opengovern/og-util/pkg/describe"
+)
+
+const (
+	ScopeSubscription    = "subscription"
+	ScopeTenant          = "tenant"
+	ScopeManagementGroup = "managementGroup"
+)
+
+const defaultSubscriptionConcurrency = 8
+
+// jobScope is what a job describes, GroupID is set for management group
+// scoped jobs.
+type jobScope struct {
+	Scope   string
+	GroupID string
+}
+
+var jobScopeKey string = "job_scope"
+
+// withJobScope reads the scope job input, falling back to DESCRIBE_SCOPE. A
+// tenant scoped job describes every subscription of the tenant and a
+// management group scoped one every subscription under the group given by
+// the managementGroupId input, the provider id of the job if there is none.
+// Both describe at most subscriptionConcurrency
+// (DESCRIBE_SUBSCRIPTION_CONCURRENCY) subscriptions at a time.
+func withJobScope(ctx context.Context, job describe2.DescribeJob, extraInputs map[string][]string) context.Context {
+	scope := inputOrEnv(extraInputs, "scope", "DESCRIBE_SCOPE")
+	switch {
+	case strings.EqualFold(scope, ScopeTenant):
+		ctx = context.WithValue(ctx, jobScopeKey, jobScope{Scope: ScopeTenant})
+		return describer.WithTenantScope(ctx, subscriptionConcurrency(extraInputs))
+	case strings.EqualFold(scope, ScopeManagementGroup):
+		groupID := inputOrEnv(extraInputs, "managementGroupId", "DESCRIBE_MANAGEMENT_GROUP_ID")
+		if groupID == "" {
+			groupID = job.ProviderID
+		}
+		ctx = context.WithValue(ctx, jobScopeKey, jobScope{Scope: ScopeManagementGroup, GroupID: groupID})
+		return describer.WithManagementGroupScope(ctx, groupID, subscriptionConcurrency(extraInputs))
+	}
+	return context.WithValue(ctx, jobScopeKey, jobScope{Scope: ScopeSubscription})
+}
+
+// withManagementGroupPath reads the managementGroupPath job input, falling
+// back to DESCRIBE_MANAGEMENT_GROUP_PATH. Set to true the resources of
+// subscription scoped jobs and refreshes record the management group
+// ancestry of their subscription, as the ones of the other scopes do.
+func withManagementGroupPath(ctx context.Context, extraInputs map[string][]string) context.Context {
+	if strings.EqualFold(inputOrEnv(extraInputs, "managementGroupPath", "DESCRIBE_MANAGEMENT_GROUP_PATH"), "true") {
+		return describer.WithManagementGroupPath(ctx)
+	}
+	return ctx
+}
+
+// getJobScopeFromContext returns the subscription scope if the job has none
+// set.
+func getJobScopeFromContext(ctx context.Context) jobScope {
+	scope, ok := ctx.Value(jobScopeKey).(jobScope)
+	if !ok {
+		return jobScope{Scope: ScopeSubscription}
+	}
+	return scope
+}
+
+func subscriptionConcurrency(extraInputs map[string][]string) int {
+	concurrency, err := strconv.Atoi(inputOrEnv(extraInputs, "subscriptionConcurrency", "DESCRIBE_SUBSCRIPTION_CONCURRENCY"))
+	if err != nil || concurrency < 1 {
+		return defaultSubscriptionConcurrency
+	}
+	return concurrency
+}
+
+func inputOrEnv(extraInputs map[string][]string, input, env string) string {
+	if in, ok := extraInputs[input]; ok && len(in) > 0 {
+		return in[0]
+	}
+	return os.Getenv(env)
+}
@@ -0,0 +1,241 @@
+package describer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/opengovern/og-util/pkg/es"
+	"go.uber.org/zap"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+type SinkType string
+
+const (
+	SinkTypeGRPC       SinkType = "grpc"
+	SinkTypeFile       SinkType = "file"
+	SinkTypeStdout     SinkType = "stdout"
+	SinkTypeOpenSearch SinkType = "opensearch"
+
+	SinkTypeIngestionPipeline SinkType = "ingestion-pipeline"
+)
+
+// Sink delivers the documents produced by a describe job.
+type Sink interface {
+	Ingest(ctx context.Context, docs []es.Doc) error
+	Close() error
+}
+
+// DocumentFailure is a single document the sink could not deliver.
+type DocumentFailure struct {
+	ResourceID string
+	ID         string
+	Index      string
+	Status     int
+	Reason     string
+}
+
+// IngestError is returned when some of the documents of a batch were rejected.
+type IngestError struct {
+	Total    int
+	Failures []DocumentFailure
+}
+
+func (e *IngestError) Error() string {
+	reasons := make([]string, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", f.ResourceID, f.Reason))
+	}
+	return fmt.Sprintf("failed to ingest %d of %d documents: %s", len(e.Failures), e.Total, strings.Join(reasons, ", "))
+}
+
+type SinkConfig struct {
+	Type SinkType
+
+	// FilePath is the JSONL file written by the file sink.
+	FilePath string
+
+	// JobSinks are the sink types a job may switch to on top of Type, and
+	// JobFileDir the directory the file sinks of jobs are confined to. Jobs
+	// can't pick a file sink without it.
+	JobSinks   []SinkType
+	JobFileDir string
+
+	// OpenSearch* configure the _bulk http sink.
+	OpenSearchAddress            string
+	OpenSearchUsername           string
+	OpenSearchPassword           string
+	OpenSearchInsecureSkipVerify bool
+
+	// IngestionPipeline* configure the OpenSearch Ingestion http sink. The
+	// endpoint comes from the job, requests are SigV4 signed unless a
+	// username is set.
+	IngestionPipelineEndpoint string
+	IngestionPipelineRegion   string
+	IngestionPipelineUsername string
+	IngestionPipelinePassword string
+
+	Spool       SpoolConfig
+	Batch       BatchConfig
+	Incremental IncrementalConfig
+}
+
+// SinkConfigFromEnv loads the worker sink configuration. The grpc sink is
+// used unless DESCRIBE_SINK says otherwise.
+func SinkConfigFromEnv() SinkConfig {
+	cfg := SinkConfig{
+		Type:                         SinkType(strings.ToLower(os.Getenv("DESCRIBE_SINK"))),
+		FilePath:                     os.Getenv("DESCRIBE_SINK_FILE"),
+		OpenSearchAddress:            os.Getenv("OPENSEARCH_ADDRESS"),
+		OpenSearchUsername:           os.Getenv("OPENSEARCH_USERNAME"),
+		OpenSearchPassword:           os.Getenv("OPENSEARCH_PASSWORD"),
+		OpenSearchInsecureSkipVerify: os.Getenv("OPENSEARCH_INSECURE_SKIP_VERIFY") == "true",
+		IngestionPipelineRegion:      os.Getenv("INGESTION_PIPELINE_REGION"),
+		IngestionPipelineUsername:    os.Getenv("INGESTION_PIPELINE_USERNAME"),
+		IngestionPipelinePassword:    os.Getenv("INGESTION_PIPELINE_PASSWORD"),
+		Spool:                        SpoolConfigFromEnv(),
+		Batch:                        BatchConfigFromEnv(),
+		Incremental:                  IncrementalConfigFromEnv(),
+	}
+	if cfg.Type == "" {
+		cfg.Type = SinkTypeGRPC
+	}
+	// DESCRIBE_JOB_SINKS=file,opensearch
+	for _, t := range strings.Split(os.Getenv("DESCRIBE_JOB_SINKS"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			cfg.JobSinks = append(cfg.JobSinks, SinkType(strings.ToLower(t)))
+		}
+	}
+	cfg.JobFileDir = os.Getenv("DESCRIBE_JOB_SINK_FILE_DIR")
+	return cfg
+}
+
+// WithJobOverrides lets a job pick its own sink through the "sink" extra
+// input, and turn incremental describes on or force a full resync through
+// "incremental" and "fullResync". The sink has to be one of JobSinks, and a
+// "sinkFile" is a relative path under JobFileDir.
+func (c SinkConfig) WithJobOverrides(extraInputs map[string][]string) (SinkConfig, error) {
+	if v := extraInputs["sink"]; len(v) > 0 && v[0] != "" {
+		sinkType := SinkType(strings.ToLower(v[0]))
+		if sinkType != c.Type && !slices.Contains(c.JobSinks, sinkType) {
+			return c, fmt.Errorf("sink %s is not allowed for jobs", sinkType)
+		}
+		c.Type = sinkType
+	}
+	if v := extraInputs["sinkFile"]; len(v) > 0 && v[0] != "" {
+		path, err := jobSinkFile(c.JobFileDir, v[0])
+		if err != nil {
+			return c, err
+		}
+		c.FilePath = path
+	}
+	if v := extraInputs["incremental"]; len(v) > 0 && v[0] != "" {
+		c.Incremental.Enabled = v[0] == "true"
+	}
+	if v := extraInputs["fullResync"]; len(v) > 0 && v[0] != "" {
+		c.Incremental.FullResync = v[0] == "true"
+	}
+	return c, nil
+}
+
+// jobSinkFile resolves the sink file a job asked for within dir.
+func jobSinkFile(dir, name string) (string, error) {
+	if dir == "" {
+		return "", fmt.Errorf("jobs can't pick a sink file")
+	}
+	if !filepath.IsLocal(name) {
+		return "", fmt.Errorf("sink file %s is not a relative path within the sink directory", name)
+	}
+	return filepath.Join(dir, name), nil
+}
+
+func NewSink(ctx context.Context, cfg SinkConfig, grpcEndpoint, authToken string, jobID uint, logger *zap.Logger) (Sink, error) {
+	switch cfg.Type {
+	case SinkTypeGRPC, "":
+		return NewGRPCSink(grpcEndpoint, authToken, jobID, cfg.Batch.Compression, logger)
+	case SinkTypeFile:
+		if cfg.FilePath == "" {
+			return nil, fmt.Errorf("file sink requires a file path")
+		}
+		return NewFileSink(cfg.FilePath)
+	case SinkTypeStdout:
+		return NewWriterSink(os.Stdout), nil
+	case SinkTypeOpenSearch:
+		return NewOpenSearchSink(cfg.OpenSearchAddress, cfg.OpenSearchUsername, cfg.OpenSearchPassword, cfg.OpenSearchInsecureSkipVerify, cfg.Batch.Compression)
+	case SinkTypeIngestionPipeline:
+		return NewIngestionPipelineSink(ctx, cfg.IngestionPipelineEndpoint, cfg.IngestionPipelineRegion, cfg.IngestionPipelineUsername, cfg.IngestionPipelinePassword, cfg.Batch.Compression)
+	default:
+		return nil, fmt.Errorf("unsupported sink type: %s", cfg.Type)
+	}
+}
+
+// WriterSink writes every document as a JSON line.
+type WriterSink struct {
+	lock sync.Mutex
+	w    io.Writer
+	enc  *json.Encoder
+}
+
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{
+		w:   w,
+		enc: json.NewEncoder(w),
+	}
+}
+
+func NewFileSink(path string) (*WriterSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sink file: %w", err)
+	}
+	return NewWriterSink(f), nil
+}
+
+func (s *WriterSink) Ingest(_ context.Context, docs []es.Doc) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, doc := range docs {
+		if err := s.enc.Encode(doc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *WriterSink) Close() error {
+	if f, ok := s.w.(*os.File); ok && f != os.Stdout {
+		return f.Close()
+	}
+	return nil
+}
+
+func documentFailure(doc es.Doc, status int, reason string) DocumentFailure {
+	keys, idx := doc.KeysAndIndex()
+	return DocumentFailure{
+		ResourceID: resourceIDOf(doc),
+		ID:         es.HashOf(keys...),
+		Index:      idx,
+		Status:     status,
+		Reason:     reason,
+	}
+}
+
+func resourceIDOf(doc es.Doc) string {
+	switch d := doc.(type) {
+	case *es.Resource:
+		return d.ResourceID
+	case es.LookupResource:
+		return d.ResourceID
+	case encodedDoc:
+		return d.ResourceID
+	case *Tombstone:
+		return d.ResourceID
+	}
+	return ""
+}
@@ -0,0 +1,143 @@
+package describer
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/opengovern/og-describer-azure/pkg/tracing"
+	"github.com/opengovern/og-util/pkg/es"
+	"github.com/opengovern/og-util/proto/src/golang"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials/oauth"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"io"
+)
+
+// GRPCSink ingests documents through the es sink service.
+type GRPCSink struct {
+	authToken    string
+	grpcEndpoint string
+	jobID        uint
+	compression  string
+	logger       *zap.Logger
+
+	conn   *grpc.ClientConn
+	client golang.EsSinkServiceClient
+}
+
+func NewGRPCSink(grpcEndpoint, authToken string, jobID uint, compression string, logger *zap.Logger) (*GRPCSink, error) {
+	s := GRPCSink{
+		authToken:    authToken,
+		grpcEndpoint: grpcEndpoint,
+		jobID:        jobID,
+		compression:  compression,
+		logger:       logger,
+	}
+	if err := s.Connect(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (s *GRPCSink) Connect() error {
+	var opts []grpc.DialOption
+	if s.authToken != "" {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})))
+		opts = append(opts, grpc.WithPerRPCCredentials(oauth.TokenSource{
+			TokenSource: oauth2.StaticTokenSource(&oauth2.Token{
+				AccessToken: s.authToken,
+			}),
+		}))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	conn, err := grpc.NewClient(
+		s.grpcEndpoint,
+		opts...,
+	)
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+
+	client := golang.NewEsSinkServiceClient(conn)
+	s.client = client
+	return nil
+}
+
+func (s *GRPCSink) Ingest(ctx context.Context, resourcesToSend []es.Doc) error {
+	md := metadata.New(map[string]string{
+		"resource-job-id": fmt.Sprintf("%d", s.jobID),
+	})
+	tracing.InjectMetadata(ctx, md)
+	grpcCtx := metadata.NewOutgoingContext(ctx, md)
+
+	docs := make([]*anypb.Any, 0, len(resourcesToSend))
+	for _, resource := range resourcesToSend {
+		docBytes, err := json.Marshal(resource)
+		if err != nil {
+			s.logger.Error("failed to marshal resource", zap.Error(err))
+			continue
+		}
+		docs = append(docs, &anypb.Any{Value: docBytes})
+	}
+
+	var callOpts []grpc.CallOption
+	if s.compression == CompressionGzip {
+		callOpts = append(callOpts, grpc.UseCompressor(gzip.Name))
+	}
+
+	_, err := s.client.Ingest(grpcCtx, &golang.IngestRequest{Docs: docs}, callOpts...)
+	if err != nil {
+		// the batch is over the message size limit of the sink, send it in halves
+		if status.Code(err) == codes.ResourceExhausted && len(resourcesToSend) > 1 {
+			mid := len(resourcesToSend) / 2
+			return s.ingestHalves(ctx, resourcesToSend[:mid], resourcesToSend[mid:])
+		}
+		if errors.Is(err, io.EOF) {
+			if err := s.Connect(); err != nil {
+				s.logger.Error("failed to reconnect", zap.Error(err))
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *GRPCSink) ingestHalves(ctx context.Context, halves ...[]es.Doc) error {
+	ingestErr := IngestError{}
+	for _, half := range halves {
+		ingestErr.Total += len(half)
+		err := s.Ingest(ctx, half)
+		if err == nil {
+			continue
+		}
+		var halfErr *IngestError
+		if errors.As(err, &halfErr) {
+			ingestErr.Failures = append(ingestErr.Failures, halfErr.Failures...)
+			continue
+		}
+		for _, doc := range half {
+			ingestErr.Failures = append(ingestErr.Failures, documentFailure(doc, 0, err.Error()))
+		}
+	}
+	if len(ingestErr.Failures) > 0 {
+		return &ingestErr
+	}
+	return nil
+}
+
+func (s *GRPCSink) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,136 @@
+package describer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"github.com/opengovern/og-util/pkg/es"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// OpenSearchSink indexes documents through the OpenSearch _bulk api.
+type OpenSearchSink struct {
+	address  string
+	username string
+	password string
+
+	compression string
+	httpClient  *http.Client
+}
+
+type bulkAction struct {
+	Index bulkActionMeta `json:"index"`
+}
+
+type bulkActionMeta struct {
+	Index string `json:"_index"`
+	ID    string `json:"_id"`
+}
+
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int `json:"status"`
+		Error  *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	} `json:"items"`
+}
+
+func NewOpenSearchSink(address, username, password string, insecureSkipVerify bool, compression string) (*OpenSearchSink, error) {
+	if address == "" {
+		return nil, fmt.Errorf("opensearch sink requires an address")
+	}
+	return &OpenSearchSink{
+		address:     strings.TrimSuffix(address, "/"),
+		username:    username,
+		password:    password,
+		compression: compression,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
+			},
+		},
+	}, nil
+}
+
+func (s *OpenSearchSink) Ingest(ctx context.Context, docs []es.Doc) error {
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, doc := range docs {
+		keys, idx := doc.KeysAndIndex()
+		if err := enc.Encode(bulkAction{Index: bulkActionMeta{Index: idx, ID: es.HashOf(keys...)}}); err != nil {
+			return err
+		}
+		if err := enc.Encode(doc); err != nil {
+			return err
+		}
+	}
+
+	payload := body.Bytes()
+	if s.compression == CompressionGzip {
+		compressed, err := gzipBytes(payload)
+		if err != nil {
+			return err
+		}
+		payload = compressed
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.address+"/_bulk", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if s.compression == CompressionGzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	if s.username != "" {
+		req.SetBasicAuth(s.username, s.password)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("bulk request failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var bulkResp bulkResponse
+	if err := json.Unmarshal(respBody, &bulkResp); err != nil {
+		return fmt.Errorf("failed to parse bulk response: %w", err)
+	}
+	if !bulkResp.Errors {
+		return nil
+	}
+
+	var failures []DocumentFailure
+	for i, item := range bulkResp.Items {
+		for _, result := range item {
+			if result.Error == nil || i >= len(docs) {
+				continue
+			}
+			failures = append(failures, documentFailure(docs[i], result.Status, fmt.Sprintf("%s: %s", result.Error.Type, result.Error.Reason)))
+		}
+	}
+	return &IngestError{Total: len(docs), Failures: failures}
+}
+
+func (s *OpenSearchSink) Close() error {
+	s.httpClient.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,183 @@
+package describer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/opengovern/og-util/pkg/es"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	PipelineBatchSize   int    = 100
+	PipelineServiceName string = "osis"
+)
+
+// IngestionPipelineSink posts documents to an OpenSearch Ingestion (Data
+// Prepper) http source. Requests are signed with SigV4 unless basic auth
+// credentials are configured.
+type IngestionPipelineSink struct {
+	endpoint string
+	region   string
+	username string
+	password string
+
+	compression string
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	httpClient  *http.Client
+}
+
+func NewIngestionPipelineSink(ctx context.Context, endpoint, region, username, password string, compression string) (*IngestionPipelineSink, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("ingestion pipeline sink requires an endpoint")
+	}
+	s := IngestionPipelineSink{
+		endpoint:    endpoint,
+		region:      region,
+		username:    username,
+		password:    password,
+		compression: compression,
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+	}
+	if username == "" {
+		cfg, err := config.LoadDefaultConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load aws config: %w", err)
+		}
+		if s.region == "" {
+			s.region = cfg.Region
+		}
+		if s.region == "" {
+			return nil, fmt.Errorf("ingestion pipeline sink requires an aws region")
+		}
+		s.credentials = aws.NewCredentialsCache(cfg.Credentials)
+		s.signer = v4.NewSigner()
+	}
+	return &s, nil
+}
+
+func (s *IngestionPipelineSink) Ingest(ctx context.Context, docs []es.Doc) error {
+	var failures []DocumentFailure
+	for page := 0; page < len(docs); page += PipelineBatchSize {
+		pageFailures, err := s.ingestBatch(ctx, docs[page:min(page+PipelineBatchSize, len(docs))])
+		if err != nil {
+			return err
+		}
+		failures = append(failures, pageFailures...)
+	}
+	if len(failures) > 0 {
+		return &IngestError{Total: len(docs), Failures: failures}
+	}
+	return nil
+}
+
+// ingestBatch sends docs in a single request. The http source accepts or
+// rejects a request as a whole, so a batch rejected for its payload is split
+// until the offending documents are isolated. Any other failure concerns the
+// whole request and is returned as is: auth rejections are permanent,
+// throttling and server errors are left to the sender's retries.
+func (s *IngestionPipelineSink) ingestBatch(ctx context.Context, docs []es.Doc) ([]DocumentFailure, error) {
+	if len(docs) == 0 {
+		return nil, nil
+	}
+
+	var failures []DocumentFailure
+	payload := make([]json.RawMessage, 0, len(docs))
+	sent := make([]es.Doc, 0, len(docs))
+	for _, doc := range docs {
+		docBytes, err := json.Marshal(doc)
+		if err != nil {
+			failures = append(failures, documentFailure(doc, 0, fmt.Sprintf("failed to marshal: %v", err)))
+			continue
+		}
+		payload = append(payload, docBytes)
+		sent = append(sent, doc)
+	}
+	if len(sent) == 0 {
+		return failures, nil
+	}
+
+	status, err := s.post(ctx, payload)
+	switch {
+	case err == nil:
+		return failures, nil
+	case status == http.StatusBadRequest || status == http.StatusRequestEntityTooLarge:
+		if len(sent) == 1 {
+			return append(failures, documentFailure(sent[0], status, err.Error())), nil
+		}
+		mid := len(sent) / 2
+		for _, half := range [][]es.Doc{sent[:mid], sent[mid:]} {
+			halfFailures, err := s.ingestBatch(ctx, half)
+			if err != nil {
+				return nil, err
+			}
+			failures = append(failures, halfFailures...)
+		}
+		return failures, nil
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return nil, Permanent(err)
+	default:
+		return nil, err
+	}
+}
+
+func (s *IngestionPipelineSink) post(ctx context.Context, payload []json.RawMessage) (int, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return 0, err
+	}
+
+	if s.compression == CompressionGzip {
+		body, err = gzipBytes(body)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	if s.compression == CompressionGzip {
+		req.Header.Add("Content-Encoding", "gzip")
+	}
+
+	if s.username != "" {
+		req.SetBasicAuth(s.username, s.password)
+	} else {
+		creds, err := s.credentials.Retrieve(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to retrieve aws credentials: %w", err)
+		}
+		err = s.signer.SignHTTP(ctx, creds, req, fmt.Sprintf("%x", sha256.Sum256(body)), PipelineServiceName, s.region, time.Now())
+		if err != nil {
+			return 0, fmt.Errorf("failed to sign request: %w", err)
+		}
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, fmt.Errorf("ingestion pipeline returned statusCode=%d, body=%s, requestSize=%d", resp.StatusCode, string(bodyBytes), len(body))
+	}
+	return resp.StatusCode, nil
+}
+
+func (s *IngestionPipelineSink) Close() error {
+	s.httpClient.CloseIdleConnections()
+	return nil
+}
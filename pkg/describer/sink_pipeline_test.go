@@ -0,0 +1,107 @@
+package describer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/opengovern/og-util/pkg/es"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestIngestionPipelineSinkIngest(t *testing.T) {
+	docs := func(n int) []es.Doc {
+		var docs []es.Doc
+		for i := 0; i < n; i++ {
+			docs = append(docs, &es.Resource{ResourceID: string(rune('a' + i))})
+		}
+		return docs
+	}
+
+	tests := []struct {
+		name         string
+		status       func(batch []map[string]any) int
+		docs         int
+		wantRequests int32
+		wantFailures int
+		wantErr      bool
+		wantPerm     bool
+	}{
+		{
+			name:         "Accepted",
+			status:       func([]map[string]any) int { return http.StatusOK },
+			docs:         4,
+			wantRequests: 1,
+		},
+		{
+			name: "PayloadRejected",
+			status: func(batch []map[string]any) int {
+				for _, doc := range batch {
+					if doc["resource_id"] == "c" {
+						return http.StatusBadRequest
+					}
+				}
+				return http.StatusOK
+			},
+			docs:         4,
+			wantRequests: 5,
+			wantFailures: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "Forbidden",
+			status:       func([]map[string]any) int { return http.StatusForbidden },
+			docs:         4,
+			wantRequests: 1,
+			wantErr:      true,
+			wantPerm:     true,
+		},
+		{
+			name:         "Throttled",
+			status:       func([]map[string]any) int { return http.StatusTooManyRequests },
+			docs:         4,
+			wantRequests: 1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				var batch []map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+					t.Errorf("failed to decode request: %v", err)
+				}
+				w.WriteHeader(tt.status(batch))
+			}))
+			defer server.Close()
+
+			sink, err := NewIngestionPipelineSink(context.Background(), server.URL, "", "user", "pass", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = sink.Ingest(context.Background(), docs(tt.docs))
+
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("sent %d requests, want %d", got, tt.wantRequests)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Ingest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if IsPermanent(err) != tt.wantPerm {
+				t.Errorf("IsPermanent() = %v, want %v", IsPermanent(err), tt.wantPerm)
+			}
+			var ingestErr *IngestError
+			if errors.As(err, &ingestErr) {
+				if len(ingestErr.Failures) != tt.wantFailures {
+					t.Errorf("got %d failures, want %d", len(ingestErr.Failures), tt.wantFailures)
+				}
+			} else if tt.wantFailures > 0 {
+				t.Errorf("Ingest() error = %v, want an IngestError", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package describer
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestWithJobOverrides(t *testing.T) {
+	cfg := SinkConfig{Type: SinkTypeGRPC, JobSinks: []SinkType{SinkTypeFile}, JobFileDir: "/var/describe"}
+
+	tests := []struct {
+		name        string
+		extraInputs map[string][]string
+		wantType    SinkType
+		wantFile    string
+		wantErr     bool
+	}{
+		{
+			name:     "None",
+			wantType: SinkTypeGRPC,
+		},
+		{
+			name:        "File",
+			extraInputs: map[string][]string{"sink": {"file"}, "sinkFile": {"jobs/out.jsonl"}},
+			wantType:    SinkTypeFile,
+			wantFile:    filepath.Join("/var/describe", "jobs/out.jsonl"),
+		},
+		{
+			name:        "NotAllowed",
+			extraInputs: map[string][]string{"sink": {"stdout"}},
+			wantErr:     true,
+		},
+		{
+			name:        "Absolute",
+			extraInputs: map[string][]string{"sink": {"file"}, "sinkFile": {"/etc/passwd"}},
+			wantErr:     true,
+		},
+		{
+			name:        "Parent",
+			extraInputs: map[string][]string{"sink": {"file"}, "sinkFile": {"jobs/../../out.jsonl"}},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cfg.WithJobOverrides(tt.extraInputs)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %+v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Type != tt.wantType || got.FilePath != tt.wantFile {
+				t.Errorf("got %s %q, want %s %q", got.Type, got.FilePath, tt.wantType, tt.wantFile)
+			}
+		})
+	}
+
+	if _, err := (SinkConfig{Type: SinkTypeFile}).WithJobOverrides(map[string][]string{"sinkFile": {"out.jsonl"}}); err == nil {
+		t.Error("picked a sink file without a sink directory")
+	}
+}
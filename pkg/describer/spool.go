@@ -0,0 +1,170 @@
+package describer
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/opengovern/og-util/pkg/es"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type SpoolConfig struct {
+	// Dir is the directory failed batches are written to, one sub directory per job.
+	Dir string
+	// InitialBackoff is the delay before the first retry, it doubles on every failed attempt up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// MaxAttempts is the number of retries before the spooled documents are given up on.
+	MaxAttempts int
+	// DrainTimeout bounds how long a finishing job keeps retrying its spool,
+	// cancelled jobs included.
+	DrainTimeout time.Duration
+}
+
+func SpoolConfigFromEnv() SpoolConfig {
+	cfg := SpoolConfig{
+		Dir:            os.Getenv("DESCRIBE_SPOOL_DIR"),
+		InitialBackoff: 2 * time.Second,
+		MaxBackoff:     time.Minute,
+		MaxAttempts:    8,
+		DrainTimeout:   5 * time.Minute,
+	}
+	if cfg.Dir == "" {
+		cfg.Dir = filepath.Join(os.TempDir(), "og-describer-spool")
+	}
+	if v, err := time.ParseDuration(os.Getenv("DESCRIBE_SPOOL_INITIAL_BACKOFF")); err == nil {
+		cfg.InitialBackoff = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("DESCRIBE_SPOOL_MAX_BACKOFF")); err == nil {
+		cfg.MaxBackoff = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("DESCRIBE_SPOOL_MAX_ATTEMPTS")); err == nil {
+		cfg.MaxAttempts = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("DESCRIBE_SPOOL_DRAIN_TIMEOUT")); err == nil {
+		cfg.DrainTimeout = v
+	}
+	return cfg
+}
+
+// Backoff returns the delay before the given retry attempt.
+func (c SpoolConfig) Backoff(attempt int) time.Duration {
+	d := c.InitialBackoff
+	for i := 0; i < attempt && d < c.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, c.MaxBackoff)
+}
+
+// spoolEntry is how an encodedDoc is stored, without its MarshalJSON so the
+// keys and index are kept alongside the document.
+type spoolEntry encodedDoc
+
+// Spool keeps the batches a sink failed to ingest on local disk until they
+// are delivered or given up on. It only retries within the process: a job
+// that is redelivered after a restart describes its resources again, so the
+// batches a previous run left behind are dropped rather than sent twice.
+type Spool struct {
+	dir string
+	seq int
+}
+
+func NewSpool(baseDir string, jobID uint) (*Spool, error) {
+	dir := filepath.Join(baseDir, fmt.Sprintf("job-%d", jobID))
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("failed to clean spool: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create spool: %w", err)
+	}
+	return &Spool{dir: dir}, nil
+}
+
+// Put writes docs as a new batch.
+func (s *Spool) Put(docs []es.Doc) error {
+	s.seq++
+	return s.write(filepath.Join(s.dir, fmt.Sprintf("batch-%08d.jsonl", s.seq)), docs)
+}
+
+// Replace overwrites a batch with the documents that are still pending.
+func (s *Spool) Replace(batch string, docs []es.Doc) error {
+	if len(docs) == 0 {
+		return s.Remove(batch)
+	}
+	return s.write(batch, docs)
+}
+
+func (s *Spool) write(path string, docs []es.Doc) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, doc := range docs {
+		ed, err := encodeDoc(doc)
+		if err != nil {
+			f.Close()
+			return err
+		}
+		if err := enc.Encode(spoolEntry(ed)); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Batches lists the pending batches, oldest first.
+func (s *Spool) Batches() ([]string, error) {
+	batches, err := filepath.Glob(filepath.Join(s.dir, "batch-*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(batches)
+	return batches, nil
+}
+
+func (s *Spool) Load(batch string) ([]es.Doc, error) {
+	f, err := os.Open(batch)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var docs []es.Doc
+	dec := json.NewDecoder(f)
+	for dec.More() {
+		var entry spoolEntry
+		if err := dec.Decode(&entry); err != nil {
+			return nil, err
+		}
+		docs = append(docs, encodedDoc(entry))
+	}
+	return docs, nil
+}
+
+func (s *Spool) Remove(batch string) error {
+	return os.Remove(batch)
+}
+
+func (s *Spool) Close() error {
+	return os.RemoveAll(s.dir)
+}
@@ -0,0 +1,95 @@
+package describer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// ErrStateConflict is returned when the state was changed since it was loaded.
+var ErrStateConflict = errors.New("describe state was changed by another run")
+
+// StateStore keeps the state of the incremental runs where every worker sees
+// it. Updates only go through if the state is still at the revision it was
+// loaded at.
+type StateStore interface {
+	// Load returns the state saved under key and its revision, nil and 0 if
+	// there is none.
+	Load(ctx context.Context, key string) ([]byte, uint64, error)
+	// Update saves the state if key is still at revision, 0 creating it, and
+	// returns the new revision. It returns ErrStateConflict otherwise.
+	Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error)
+	// Put saves the state whatever its revision.
+	Put(ctx context.Context, key string, value []byte) error
+	// Delete removes key, it is not an error if there is none.
+	Delete(ctx context.Context, key string) error
+	// MaxValueSize is the size of the largest value the store takes, 0 if
+	// there is no limit.
+	MaxValueSize() int
+}
+
+// StateMaxValueSize bounds the values of the describe state bucket, it stays
+// clear of the 1MB NATS max payload.
+const StateMaxValueSize = 512 * 1024
+
+// KVStateStore keeps the state in a JetStream key value bucket.
+type KVStateStore struct {
+	kv jetstream.KeyValue
+}
+
+func NewKVStateStore(ctx context.Context, js jetstream.JetStream, bucket string) (*KVStateStore, error) {
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:      bucket,
+		Description: "description hashes of the incremental describe runs",
+		History:     1,
+		// the state of a run is split over values that fit
+		MaxValueSize: StateMaxValueSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create describe state bucket: %w", err)
+	}
+	return &KVStateStore{kv: kv}, nil
+}
+
+func (s *KVStateStore) Load(ctx context.Context, key string) ([]byte, uint64, error) {
+	entry, err := s.kv.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+	return entry.Value(), entry.Revision(), nil
+}
+
+func (s *KVStateStore) Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	var err error
+	if revision == 0 {
+		revision, err = s.kv.Create(ctx, key, value)
+	} else {
+		revision, err = s.kv.Update(ctx, key, value, revision)
+	}
+	if errors.Is(err, jetstream.ErrKeyExists) {
+		return 0, ErrStateConflict
+	}
+	return revision, err
+}
+
+func (s *KVStateStore) Put(ctx context.Context, key string, value []byte) error {
+	_, err := s.kv.Put(ctx, key, value)
+	return err
+}
+
+func (s *KVStateStore) Delete(ctx context.Context, key string) error {
+	err := s.kv.Delete(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (s *KVStateStore) MaxValueSize() int {
+	return StateMaxValueSize
+}
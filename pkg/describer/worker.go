@@ -6,13 +6,18 @@ import (
 	"fmt"
 	"github.com/go-errors/errors"
 	"github.com/google/uuid"
+	"github.com/opengovern/og-describer-azure/pkg/metrics"
+	"github.com/opengovern/og-describer-azure/pkg/redact"
 	model "github.com/opengovern/og-describer-azure/pkg/sdk/models"
+	"github.com/opengovern/og-describer-azure/pkg/tracing"
 	"github.com/opengovern/og-describer-azure/provider"
 	"github.com/opengovern/og-describer-azure/provider/configs"
+	"github.com/opengovern/og-describer-azure/provider/describer"
 	"github.com/opengovern/og-describer-azure/steampipe"
 	describe2 "github.com/opengovern/og-util/pkg/describe"
 	"github.com/opengovern/og-util/pkg/es"
 	"github.com/opengovern/og-util/pkg/vault"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"go.uber.org/zap"
 	"strconv"
 	"strings"
@@ -24,6 +29,27 @@ type Error struct {
 	error
 }
 
+// PermanentError marks a failure that retrying the job won't fix.
+type PermanentError struct {
+	error
+}
+
+func (e PermanentError) Unwrap() error {
+	return e.error
+}
+
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return PermanentError{error: err}
+}
+
+func IsPermanent(err error) bool {
+	var perr PermanentError
+	return errors.As(err, &perr)
+}
+
 func trimEmptyMaps(input map[string]any) {
 	for key, value := range input {
 		switch value.(type) {
@@ -55,7 +81,8 @@ func Do(ctx context.Context,
 	grpcEndpoint string,
 	describeDeliverToken string,
 	ingestionPipelineEndpoint string,
-	useOpenSearch bool) (resourceIDs []string, err error) {
+	useOpenSearch bool,
+	sinkCfg SinkConfig) (resourceIDs []string, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("paniced with error: %v", r)
@@ -66,13 +93,14 @@ func Do(ctx context.Context,
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	config, err := vlt.Decrypt(ctx, job.CipherText)
+	decryptCtx, decryptSpan := tracing.Tracer().Start(ctx, "vault.Decrypt")
+	config, err := vlt.Decrypt(decryptCtx, job.CipherText)
+	tracing.End(decryptSpan, err)
 	if err != nil {
-		return nil, fmt.Errorf("decrypt error: %w", err)
+		return nil, Permanent(fmt.Errorf("decrypt error: %w", err))
 	}
-	logger.Info("decrypted config", zap.Any("config", config))
 
-	return doDescribe(ctx, logger, job, config, grpcEndpoint, ingestionPipelineEndpoint, describeDeliverToken, useOpenSearch)
+	return doDescribe(ctx, logger, job, config, grpcEndpoint, ingestionPipelineEndpoint, describeDeliverToken, useOpenSearch, sinkCfg)
 }
 
 func doDescribe(
@@ -82,13 +110,12 @@ func doDescribe(
 	config map[string]any,
 	grpcEndpoint, ingestionPipelineEndpoint string,
 	describeToken string,
-	useOpenSearch bool) ([]string, error) {
-	logger.Info("Making New Resource Sender")
-	rs, err := NewResourceSender(grpcEndpoint, ingestionPipelineEndpoint, describeToken, job.JobID, useOpenSearch, logger)
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to resource sender: %w", err)
+	useOpenSearch bool,
+	sinkCfg SinkConfig) ([]string, error) {
+	if useOpenSearch {
+		sinkCfg.Type = SinkTypeIngestionPipeline
+		sinkCfg.IngestionPipelineEndpoint = ingestionPipelineEndpoint
 	}
-
 	logger.Info("Connect to steampipe plugin")
 	plg := steampipe.Plugin()
 	logger.Info("Account Config From Map")
@@ -96,91 +123,236 @@ func doDescribe(
 	if err != nil {
 		return nil, fmt.Errorf(" account credentials: %w", err)
 	}
+	redactor, err := descriptionRedactor(job.ResourceType)
+	if err != nil {
+		return nil, Permanent(err)
+	}
+	additionalParameters, err := provider.GetAdditionalParameters(job)
+	if err != nil {
+		return nil, err
+	}
 
-	f := func(resource model.Resource) error {
-		if resource.Description == nil {
-			return nil
-		}
-		descriptionJSON, err := json.Marshal(resource.Description)
+	// a refresh describes one resource, the state of the others is unknown
+	refreshResourceID := getRefreshResourceFromContext(ctx)
+	var inc *incrementalRun
+	if sinkCfg.Incremental.Enabled && refreshResourceID == "" {
+		inc, err = newIncrementalRun(ctx, sinkCfg.Incremental, job, getJobScopeFromContext(ctx))
 		if err != nil {
-			return fmt.Errorf("failed to marshal description: %w", err)
+			// without the previous state every resource is sent
+			logger.Error("failed to load incremental state, sending all resources", zap.Error(err))
+			metrics.IncrementalFallbacks.WithLabelValues(job.ResourceType, "load_failed").Inc()
+			inc = nil
+		} else if inc.Stale() {
+			logger.Info("incremental state may be outdated, sending all resources")
+			metrics.IncrementalFallbacks.WithLabelValues(job.ResourceType, "stale").Inc()
 		}
-		descriptionJSON, err = trimJsonFromEmptyObjects(descriptionJSON)
-		if err != nil {
-			return fmt.Errorf("failed to trim json: %w", err)
+	}
+
+	// the sender is only set up once nothing can fail before it is finished
+	logger.Info("Making New Resource Sender", zap.String("sink", string(sinkCfg.Type)))
+	sink, err := NewSink(ctx, sinkCfg, grpcEndpoint, describeToken, job.JobID, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to resource sender: %w", err)
+	}
+	rs := NewResourceSender(ctx, sink, sinkCfg, job.JobID, logger)
+	// unchanged resources are described but not sent, they are still
+	// reported as described
+	resourceIDs := func() []string {
+		ids := rs.GetResourceIDs()
+		if inc != nil {
+			ids = append(ids, inc.Unchanged()...)
 		}
+		return ids
+	}
 
-		metadata, err := provider.GetResourceMetadata(job, resource)
+	progress := describer.GetProgressFromContext(ctx)
+	f := func(resource model.Resource) error {
+		progress.Touch()
+		r, err := buildResource(logger, plg, job, resource, redactor)
 		if err != nil {
-			return fmt.Errorf("failed to get resource metadata")
+			return err
 		}
-		err = provider.AdjustResource(job, &resource)
-		if err != nil {
-			return fmt.Errorf("failed to get resource metadata")
+		if r == nil {
+			return nil
 		}
+		metrics.ResourcesEmitted.WithLabelValues(job.ResourceType).Inc()
+		if inc != nil {
+			changed, err := inc.Changed(r)
+			if err != nil {
+				return fmt.Errorf("failed to hash resource: %w", err)
+			}
+			if !changed {
+				return nil
+			}
+		}
+		rs.Send(r)
+		return nil
+	}
+	clientStream := (*model.StreamSender)(&f)
 
-		desc := resource.Description
-		err = json.Unmarshal(descriptionJSON, &desc)
-		if err != nil {
-			return fmt.Errorf("unmarshal description: %v", err.Error())
+	if refreshResourceID != "" {
+		err = refreshResource(ctx, logger, job, creds, additionalParameters, refreshResourceID, clientStream, rs)
+	} else {
+		err = GetResources(
+			ctx,
+			logger,
+			job.ResourceType,
+			job.TriggerType,
+			creds,
+			additionalParameters,
+			clientStream,
+		)
+	}
+	if err != nil {
+		// still deliver what was described before the failure
+		if err := rs.Finish(); err != nil {
+			logger.Error("failed to finish resource sender", zap.Error(err), zap.Uint("jobID", job.JobID))
 		}
+		if errCode := provider.ClassifyError(err); errCode != "" {
+			err = Error{ErrCode: errCode, error: err}
+		}
+		return resourceIDs(), err
+	}
 
-		tags, _, err := steampipe.ExtractTagsAndNames(logger, plg, job.ResourceType, resource)
-		if err != nil {
-			logger.Error("failed to build tags for service", zap.Error(err), zap.String("resourceType", job.ResourceType), zap.Any("resource", resource))
+	partialErr := partialResult(ctx)
+	// resources skipped in partial success mode are not gone
+	if inc != nil && partialErr == nil {
+		tombstones := inc.Tombstones()
+		logger.Info("sending tombstones", zap.Int("count", len(tombstones)))
+		for _, t := range tombstones {
+			rs.SendDoc(t)
 		}
+	}
 
-		var description any
-		err = json.Unmarshal([]byte(descriptionJSON), &description)
-		if err != nil {
-			logger.Error("failed to parse resource description json", zap.Error(err))
-			return fmt.Errorf("failed to parse resource description json")
+	if err := rs.Finish(); err != nil {
+		var deliveryErr *DeliveryError
+		if errors.As(err, &deliveryErr) {
+			errCode := ErrCodePartialDelivery
+			if len(deliveryErr.Undelivered) >= deliveryErr.Total {
+				errCode = ErrCodeDeliveryFailed
+			}
+			logger.Error("resources were not delivered", zap.Uint("jobID", job.JobID), zap.Strings("resourceIDs", deliveryErr.Undelivered))
+			return resourceIDs(), Error{ErrCode: errCode, error: err}
 		}
+		return resourceIDs(), err
+	}
 
-		newTags := make([]es.Tag, 0, len(tags))
-		for k, v := range tags {
-			newTags = append(newTags, es.Tag{
-				// tags should be case-insensitive
-				Key:   strings.ToLower(k),
-				Value: strings.ToLower(v),
-			})
+	// the state moves on only once everything it covers is delivered, the
+	// tombstones included, or they would not be sent again
+	if inc != nil && rs.UndeliveredDocs() > 0 {
+		logger.Warn("keeping the incremental state, some tombstones were not delivered", zap.Uint("jobID", job.JobID))
+	} else if inc != nil {
+		if err := inc.Save(ctx, partialErr != nil); err != nil {
+			logger.Error("failed to save incremental state", zap.Error(err), zap.Uint("jobID", job.JobID))
+			metrics.IncrementalFallbacks.WithLabelValues(job.ResourceType, "save_failed").Inc()
 		}
+	}
 
-		rs.Send(&es.Resource{
-			PlatformID:          uuid.New().String(),
-			ResourceID:          resource.UniqueID(),
-			ResourceName:        resource.Name,
-			Description:         description,
-			IntegrationType:     configs.IntegrationName,
-			ResourceType:        strings.ToLower(job.ResourceType),
-			IntegrationID:       job.IntegrationID,
-			IntegrationMetadata: metadata,
-			CanonicalTags:       newTags,
-			DescribedAt:         job.DescribedAt,
-			DescribedBy:         strconv.FormatUint(uint64(job.JobID), 10),
-		})
-		return nil
+	return resourceIDs(), partialErr
+}
+
+// descriptionRedactor masks the worker wide denylist and the paths listed by
+// the resource type.
+func descriptionRedactor(resourceType string) (*redact.Redactor, error) {
+	paths := append(append([]string{}, redact.DefaultPaths...), redact.PathsFromEnv()...)
+	if rt, err := GetResourceType(resourceType); err == nil {
+		paths = append(paths, rt.Redact...)
 	}
-	clientStream := (*model.StreamSender)(&f)
+	redactor, err := redact.NewRedactor(paths)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build redactor: %w", err)
+	}
+	return redactor, nil
+}
 
-	additionalParameters, err := provider.GetAdditionalParameters(job)
+// buildResource converts a described resource into the document that is
+// delivered to the sink. It returns nil if the resource has no description.
+func buildResource(logger *zap.Logger, plg *plugin.Plugin, job describe2.DescribeJob, resource model.Resource, redactor *redact.Redactor) (*es.Resource, error) {
+	if resource.Description == nil {
+		return nil, nil
+	}
+	descriptionJSON, err := json.Marshal(resource.Description)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to marshal description: %w", err)
 	}
-	err = GetResources(
-		ctx,
-		logger,
-		job.ResourceType,
-		job.TriggerType,
-		creds,
-		additionalParameters,
-		clientStream,
-	)
+	descriptionJSON, err = trimJsonFromEmptyObjects(descriptionJSON)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to trim json: %w", err)
 	}
 
-	rs.Finish()
+	metadata, err := provider.GetResourceMetadata(job, resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource metadata")
+	}
+	err = provider.AdjustResource(job, &resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource metadata")
+	}
+
+	desc := resource.Description
+	err = json.Unmarshal(descriptionJSON, &desc)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal description: %v", err.Error())
+	}
+
+	tags, _, err := steampipe.ExtractTagsAndNames(logger, plg, job.ResourceType, resource)
+	if err != nil {
+		logger.Error("failed to build tags for service", zap.Error(err), zap.String("resourceType", job.ResourceType), zap.String("resourceID", resource.UniqueID()))
+	}
+
+	var description any
+	err = json.Unmarshal([]byte(descriptionJSON), &description)
+	if err != nil {
+		logger.Error("failed to parse resource description json", zap.Error(err))
+		return nil, fmt.Errorf("failed to parse resource description json")
+	}
+	redactor.Apply(description)
+
+	newTags := make([]es.Tag, 0, len(tags))
+	for k, v := range tags {
+		newTags = append(newTags, es.Tag{
+			// tags should be case-insensitive
+			Key:   strings.ToLower(k),
+			Value: strings.ToLower(v),
+		})
+	}
 
-	return rs.GetResourceIDs(), nil
+	return &es.Resource{
+		// stable across runs so downstream can follow a resource
+		PlatformID:          platformIDOf(job, resource.UniqueID()),
+		ResourceID:          resource.UniqueID(),
+		ResourceName:        resource.Name,
+		Description:         description,
+		IntegrationType:     configs.IntegrationName,
+		ResourceType:        strings.ToLower(job.ResourceType),
+		IntegrationID:       job.IntegrationID,
+		IntegrationMetadata: metadata,
+		CanonicalTags:       newTags,
+		DescribedAt:         job.DescribedAt,
+		DescribedBy:         strconv.FormatUint(uint64(job.JobID), 10),
+	}, nil
+}
+
+func platformIDOf(job describe2.DescribeJob, resourceID string) string {
+	name := fmt.Sprintf("%s/%s/%s", job.IntegrationID, strings.ToLower(job.ResourceType), resourceID)
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(name)).String()
+}
+
+// lookupResourceOf builds the inventory lookup document of a resource.
+func lookupResourceOf(resource *es.Resource) es.LookupResource {
+	lookupResource := es.LookupResource{
+		PlatformID:      resource.PlatformID,
+		ResourceID:      resource.ResourceID,
+		ResourceName:    resource.ResourceName,
+		IntegrationType: configs.IntegrationName,
+		ResourceType:    strings.ToLower(resource.ResourceType),
+		IntegrationID:   resource.IntegrationID,
+		DescribedBy:     resource.DescribedBy,
+		DescribedAt:     resource.DescribedAt,
+		Tags:            resource.CanonicalTags,
+	}
+	lookupKeys, lookupIdx := lookupResource.KeysAndIndex()
+	lookupResource.EsID = es.HashOf(lookupKeys...)
+	lookupResource.EsIndex = lookupIdx
+	return lookupResource
 }
@@ -0,0 +1,106 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+const namespace = "og_describer_azure"
+
+var (
+	JobsStarted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "jobs_started_total",
+		Help:      "Describe jobs started, by resource type.",
+	}, []string{"resource_type"})
+	JobsSucceeded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "jobs_succeeded_total",
+		Help:      "Describe jobs that succeeded, by resource type.",
+	}, []string{"resource_type"})
+	JobsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "jobs_failed_total",
+		Help:      "Describe jobs that failed, by resource type and error code.",
+	}, []string{"resource_type", "error_code"})
+	JobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "job_duration_seconds",
+		Help:      "Duration of describe jobs, by resource type and status.",
+		// 1s up to about 4.5h
+		Buckets: prometheus.ExponentialBuckets(1, 2, 15),
+	}, []string{"resource_type", "status"})
+
+	ResourcesEmitted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "resources_emitted_total",
+		Help:      "Resources described and handed to the sink, by resource type.",
+	}, []string{"resource_type"})
+
+	IncrementalFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "incremental_fallbacks_total",
+		Help:      "Incremental jobs that sent every resource or couldn't save their state, by resource type and reason.",
+	}, []string{"resource_type", "reason"})
+
+	IngestBatches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ingest_batches_total",
+		Help:      "Batches sent to the sink, retries included.",
+	}, []string{"sink"})
+	IngestBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ingest_bytes_total",
+		Help:      "Uncompressed bytes of the documents sent to the sink.",
+	}, []string{"sink"})
+	IngestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ingest_errors_total",
+		Help:      "Batches the sink failed to ingest in full or in part.",
+	}, []string{"sink"})
+
+	AzureAPICalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "azure_api_calls_total",
+		Help:      "Azure API calls, by resource provider and status code.",
+	}, []string{"provider", "status_code"})
+	AzureAPIThrottled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "azure_api_throttled_total",
+		Help:      "Azure API calls that were throttled, by resource provider.",
+	}, []string{"provider"})
+	AzureAPIThrottleWait = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "azure_api_throttle_wait_seconds_total",
+		Help:      "Time Azure API calls were held back to stay under the rate limits, by resource provider.",
+	}, []string{"provider"})
+)
+
+// Serve exposes the metrics on METRICS_ADDRESS, :9090 by default. It is a
+// no-op if METRICS_ADDRESS is set to an empty value.
+func Serve(logger *zap.Logger) {
+	addr, ok := os.LookupEnv("METRICS_ADDRESS")
+	if !ok {
+		addr = ":9090"
+	}
+	if addr == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		logger.Info("serving metrics", zap.String("address", addr))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("metrics server failed", zap.Error(err))
+		}
+	}()
+}
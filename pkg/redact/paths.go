@@ -0,0 +1,135 @@
+package redact
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DefaultPaths are redacted from every description.
+var DefaultPaths = []string{
+	"$..password",
+	"$..adminPassword",
+	"$..clientSecret",
+	"$..connectionString",
+	"$..primaryConnectionString",
+	"$..secondaryConnectionString",
+	"$..primaryKey",
+	"$..secondaryKey",
+	"$..accountKey",
+	"$..accessKey",
+	"$..sasToken",
+	"$..protectedSettings",
+}
+
+// PathsFromEnv reads the comma separated DESCRIBE_REDACT_PATHS.
+func PathsFromEnv() []string {
+	var paths []string
+	for _, p := range strings.Split(os.Getenv("DESCRIBE_REDACT_PATHS"), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+type segment struct {
+	key       string
+	recursive bool
+}
+
+func (s segment) matches(key string) bool {
+	return s.key == "*" || strings.EqualFold(s.key, key)
+}
+
+type path []segment
+
+// parsePath accepts a JSONPath-like subset: keys separated by '.', '*' or
+// '[*]' for any key or array element, and '..' to match at any depth, e.g.
+// $.Site.Properties.SiteConfig.AppSettings[*].Value or $..password. Keys
+// are case-insensitive.
+func parsePath(s string) (path, error) {
+	expr := strings.ReplaceAll(strings.TrimSpace(s), "[*]", ".*")
+	expr = strings.TrimPrefix(expr, "$")
+	if !strings.HasPrefix(expr, ".") {
+		expr = "." + expr
+	}
+
+	var p path
+	recursive := false
+	for i, part := range strings.Split(expr[1:], ".") {
+		if part == "" {
+			if i == 0 || !recursive {
+				recursive = true
+				continue
+			}
+			return nil, fmt.Errorf("invalid redaction path %q", s)
+		}
+		p = append(p, segment{key: part, recursive: recursive})
+		recursive = false
+	}
+	if len(p) == 0 || recursive {
+		return nil, fmt.Errorf("invalid redaction path %q", s)
+	}
+	return p, nil
+}
+
+// Redactor masks the values a list of paths point to.
+type Redactor struct {
+	paths []path
+}
+
+func NewRedactor(paths []string) (*Redactor, error) {
+	r := Redactor{}
+	for _, s := range paths {
+		p, err := parsePath(s)
+		if err != nil {
+			return nil, err
+		}
+		r.paths = append(r.paths, p)
+	}
+	return &r, nil
+}
+
+// Apply masks the matching values of doc in place, doc is expected to be
+// decoded json.
+func (r *Redactor) Apply(doc any) {
+	if r == nil {
+		return
+	}
+	for _, p := range r.paths {
+		apply(doc, p)
+	}
+}
+
+func apply(node any, p path) {
+	seg := p[0]
+	switch n := node.(type) {
+	case map[string]any:
+		for k, v := range n {
+			if seg.matches(k) {
+				if len(p) == 1 {
+					n[k] = Mask
+					continue
+				}
+				apply(v, p[1:])
+			}
+			if seg.recursive {
+				apply(v, p)
+			}
+		}
+	case []any:
+		for i, v := range n {
+			if seg.key == "*" {
+				if len(p) == 1 {
+					n[i] = Mask
+					continue
+				}
+				apply(v, p[1:])
+			}
+			if seg.recursive {
+				apply(v, p)
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package redact
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRedactorApply(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		doc   string
+		want  string
+	}{
+		{
+			name:  "Path",
+			paths: []string{"$.Site.Properties.AppSettings[*].Value"},
+			doc:   `{"Site":{"Properties":{"AppSettings":[{"Name":"a","Value":"secret"}]}}}`,
+			want:  `{"Site":{"Properties":{"AppSettings":[{"Name":"a","Value":"[REDACTED]"}]}}}`,
+		},
+		{
+			name:  "Recursive",
+			paths: []string{"$..password"},
+			doc:   `{"a":{"b":[{"Password":"x","user":"y"}]},"password":"z"}`,
+			want:  `{"a":{"b":[{"Password":"[REDACTED]","user":"y"}]},"password":"[REDACTED]"}`,
+		},
+		{
+			name:  "Wildcard",
+			paths: []string{"ExtensionsSettings.*.commandToExecute"},
+			doc:   `{"ExtensionsSettings":{"ext1":{"commandToExecute":"run","fileUris":["u"]}}}`,
+			want:  `{"ExtensionsSettings":{"ext1":{"commandToExecute":"[REDACTED]","fileUris":["u"]}}}`,
+		},
+		{
+			name:  "NoMatch",
+			paths: []string{"$.missing.key"},
+			doc:   `{"key":"value"}`,
+			want:  `{"key":"value"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRedactor(tt.paths)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var doc any
+			if err := json.Unmarshal([]byte(tt.doc), &doc); err != nil {
+				t.Fatal(err)
+			}
+			r.Apply(doc)
+			got, _ := json.Marshal(doc)
+			if string(got) != tt.want {
+				t.Errorf("Apply() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePathInvalid(t *testing.T) {
+	for _, p := range []string{"", "$", "$..", "a...b"} {
+		if _, err := parsePath(p); err == nil {
+			t.Errorf("parsePath(%q) succeeded, want an error", p)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Package redact masks secrets in logs and in the descriptions the worker
+// sends out.
+package redact
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const Mask = "[REDACTED]"
+
+// secretKeys are compared with the keys lower cased and without '_' and '-'.
+var secretKeys = map[string]struct{}{
+	"password":                  {},
+	"adminpassword":             {},
+	"clientpassword":            {},
+	"secret":                    {},
+	"clientsecret":              {},
+	"token":                     {},
+	"accesstoken":               {},
+	"refreshtoken":              {},
+	"sastoken":                  {},
+	"apikey":                    {},
+	"accesskey":                 {},
+	"accountkey":                {},
+	"primarykey":                {},
+	"secondarykey":              {},
+	"privatekey":                {},
+	"certificate":               {},
+	"certificatepassword":       {},
+	"connectionstring":          {},
+	"primaryconnectionstring":   {},
+	"secondaryconnectionstring": {},
+	"protectedsettings":         {},
+	"authorization":             {},
+}
+
+// IsSecretKey reports whether a value stored under key is a known secret.
+func IsSecretKey(key string) bool {
+	key = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	_, ok := secretKeys[key]
+	return ok
+}
+
+// Value returns v with the values of secret keys masked, at any depth. Values
+// that are not plain maps and slices go through a json round trip first.
+func Value(v any) any {
+	switch v.(type) {
+	case nil, string, bool, int, int64, uint, uint64, float64:
+		return v
+	case map[string]any, []any:
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return v
+		}
+		var generic any
+		if err := json.Unmarshal(data, &generic); err != nil {
+			return v
+		}
+		v = generic
+	}
+	return maskSecrets(v)
+}
+
+func maskSecrets(v any) any {
+	switch n := v.(type) {
+	case map[string]any:
+		masked := make(map[string]any, len(n))
+		for k, val := range n {
+			if IsSecretKey(k) {
+				masked[k] = Mask
+				continue
+			}
+			masked[k] = maskSecrets(val)
+		}
+		return masked
+	case []any:
+		masked := make([]any, len(n))
+		for i, val := range n {
+			masked[i] = maskSecrets(val)
+		}
+		return masked
+	}
+	return v
+}
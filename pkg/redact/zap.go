@@ -0,0 +1,96 @@
+package redact
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/buffer"
+	"go.uber.org/zap/zapcore"
+)
+
+func init() {
+	for _, name := range []string{"json", "console"} {
+		name := name
+		err := zap.RegisterEncoder("redacted-"+name, func(cfg zapcore.EncoderConfig) (zapcore.Encoder, error) {
+			if name == "console" {
+				return NewEncoder(zapcore.NewConsoleEncoder(cfg)), nil
+			}
+			return NewEncoder(zapcore.NewJSONEncoder(cfg)), nil
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// NewProductionLogger is zap.NewProduction with secrets masked.
+func NewProductionLogger() (*zap.Logger, error) {
+	cfg := zap.NewProductionConfig()
+	cfg.Encoding = "redacted-" + cfg.Encoding
+	return cfg.Build()
+}
+
+// NewDevelopmentLogger is zap.NewDevelopment with secrets masked.
+func NewDevelopmentLogger() (*zap.Logger, error) {
+	cfg := zap.NewDevelopmentConfig()
+	cfg.Encoding = "redacted-" + cfg.Encoding
+	return cfg.Build()
+}
+
+// encoder masks the fields stored under a secret key, and the secret keys
+// of the maps and structs logged with zap.Any.
+type encoder struct {
+	zapcore.Encoder
+}
+
+func NewEncoder(enc zapcore.Encoder) zapcore.Encoder {
+	return encoder{Encoder: enc}
+}
+
+func (e encoder) Clone() zapcore.Encoder {
+	return encoder{Encoder: e.Encoder.Clone()}
+}
+
+func (e encoder) AddString(key, value string) {
+	if IsSecretKey(key) {
+		value = Mask
+	}
+	e.Encoder.AddString(key, value)
+}
+
+func (e encoder) AddByteString(key string, value []byte) {
+	if IsSecretKey(key) {
+		e.Encoder.AddString(key, Mask)
+		return
+	}
+	e.Encoder.AddByteString(key, value)
+}
+
+func (e encoder) AddReflected(key string, value interface{}) error {
+	if IsSecretKey(key) {
+		e.Encoder.AddString(key, Mask)
+		return nil
+	}
+	return e.Encoder.AddReflected(key, Value(value))
+}
+
+func (e encoder) EncodeEntry(entry zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
+	masked := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		masked[i] = maskField(f)
+	}
+	return e.Encoder.EncodeEntry(entry, masked)
+}
+
+func maskField(f zapcore.Field) zapcore.Field {
+	switch f.Type {
+	case zapcore.StringType, zapcore.ByteStringType, zapcore.StringerType:
+		if IsSecretKey(f.Key) {
+			return zap.String(f.Key, Mask)
+		}
+	case zapcore.ReflectType:
+		if IsSecretKey(f.Key) {
+			return zap.String(f.Key, Mask)
+		}
+		return zap.Reflect(f.Key, Value(f.Interface))
+	}
+	return f
+}
@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"context"
+	"runtime/metrics"
+	"sync"
+)
+
+// heapMetric is the heap the last GC found live, reading it doesn't force a
+// collection.
+const heapMetric = "/gc/heap/live:bytes"
+
+// admission decides when a received job may start. A job waits for a free
+// slot, for the heap to drop under the budget and for its integration to be
+// under its cap. The first job is always let in so an oversized job can't
+// stall the worker.
+type admission struct {
+	cfg WorkerConfig
+
+	lock           sync.Mutex
+	cond           *sync.Cond
+	running        int
+	perIntegration map[string]int
+}
+
+func newAdmission(cfg WorkerConfig) *admission {
+	a := &admission{
+		cfg:            cfg,
+		perIntegration: make(map[string]int),
+	}
+	a.cond = sync.NewCond(&a.lock)
+	return a
+}
+
+// busy reports whether the integration is at its cap, its jobs would wait
+// for one of its own jobs to finish rather than for a free slot.
+func (a *admission) busy(integrationID string) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.integrationAtCap(integrationID)
+}
+
+// acquire blocks until the job may start. It returns false if ctx is done
+// first.
+func (a *admission) acquire(ctx context.Context, integrationID string) bool {
+	stop := context.AfterFunc(ctx, func() {
+		a.lock.Lock()
+		defer a.lock.Unlock()
+		a.cond.Broadcast()
+	})
+	defer stop()
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	for {
+		if ctx.Err() != nil {
+			return false
+		}
+		if !a.integrationAtCap(integrationID) &&
+			(a.running == 0 || (a.running < a.cfg.MaxConcurrentJobs && a.underHeapBudget())) {
+			a.running++
+			a.perIntegration[integrationID]++
+			return true
+		}
+		a.cond.Wait()
+	}
+}
+
+func (a *admission) integrationAtCap(integrationID string) bool {
+	return a.cfg.MaxJobsPerIntegration > 0 && a.perIntegration[integrationID] >= a.cfg.MaxJobsPerIntegration
+}
+
+func (a *admission) release(integrationID string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.running--
+	a.perIntegration[integrationID]--
+	if a.perIntegration[integrationID] <= 0 {
+		delete(a.perIntegration, integrationID)
+	}
+	a.cond.Broadcast()
+}
+
+func (a *admission) underHeapBudget() bool {
+	if a.cfg.HeapBudgetBytes == 0 {
+		return true
+	}
+	return heapLive() < a.cfg.HeapBudgetBytes
+}
+
+func heapLive() uint64 {
+	sample := []metrics.Sample{{Name: heapMetric}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
@@ -0,0 +1,123 @@
+package sdk
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type WorkerConfig struct {
+	// MaxConcurrentJobs is the number of jobs a worker runs at the same time.
+	MaxConcurrentJobs int
+	// MaxJobsPerIntegration caps the jobs of a single integration, 0 means no cap.
+	MaxJobsPerIntegration int
+	// HeapBudgetBytes holds new jobs back while the live heap is above it, 0 means no budget.
+	HeapBudgetBytes uint64
+	// MaxHeldJobs is the number of jobs of integrations at their cap the
+	// worker holds on to, the consumer waits once it holds this many.
+	MaxHeldJobs int
+
+	// JobTimeout is the time budget of a job, JobTimeoutOverrides replaces it
+	// for the resource types that need more (or less).
+	JobTimeout          time.Duration
+	JobTimeoutOverrides map[string]time.Duration
+	// IdleTimeout cancels a job that made no progress for this long.
+	IdleTimeout time.Duration
+	// AckWait is how long NATS waits for a heartbeat before redelivering a job.
+	AckWait time.Duration
+
+	// NakBaseDelay is the redelivery delay of a failed job, it doubles on
+	// every delivery up to NakMaxDelay.
+	NakBaseDelay time.Duration
+	NakMaxDelay  time.Duration
+	// MaxDeliveries is the number of deliveries after which a failing job is
+	// dead lettered.
+	MaxDeliveries uint64
+
+	// ShutdownGrace is how long running jobs get once the worker is asked to
+	// stop. They may finish within the first half, the ones still running are
+	// then cancelled and get the second half to flush and report.
+	ShutdownGrace time.Duration
+}
+
+func WorkerConfigFromEnv() WorkerConfig {
+	cfg := WorkerConfig{
+		MaxConcurrentJobs:   1,
+		JobTimeout:          2 * time.Hour,
+		JobTimeoutOverrides: make(map[string]time.Duration),
+		IdleTimeout:         10 * time.Minute,
+		AckWait:             5 * time.Minute,
+		NakBaseDelay:        30 * time.Second,
+		NakMaxDelay:         10 * time.Minute,
+		MaxDeliveries:       10,
+		ShutdownGrace:       25 * time.Second,
+	}
+	if v, err := strconv.Atoi(os.Getenv("WORKER_MAX_CONCURRENT_JOBS")); err == nil && v > 0 {
+		cfg.MaxConcurrentJobs = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("WORKER_MAX_JOBS_PER_INTEGRATION")); err == nil && v > 0 {
+		cfg.MaxJobsPerIntegration = v
+	}
+	if v, err := strconv.ParseUint(os.Getenv("WORKER_HEAP_BUDGET_MB"), 10, 64); err == nil {
+		cfg.HeapBudgetBytes = v * 1024 * 1024
+	}
+	cfg.MaxHeldJobs = cfg.MaxConcurrentJobs
+	if v, err := strconv.Atoi(os.Getenv("WORKER_MAX_HELD_JOBS")); err == nil && v > 0 {
+		cfg.MaxHeldJobs = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("WORKER_JOB_TIMEOUT")); err == nil && v > 0 {
+		cfg.JobTimeout = v
+	}
+	// WORKER_JOB_TIMEOUT_OVERRIDES=Microsoft.Storage/storageAccounts=4h,Microsoft.Insights/metrics=3h
+	for _, kv := range strings.Split(os.Getenv("WORKER_JOB_TIMEOUT_OVERRIDES"), ",") {
+		resourceType, timeout, ok := strings.Cut(strings.TrimSpace(kv), "=")
+		if !ok {
+			continue
+		}
+		if v, err := time.ParseDuration(timeout); err == nil && v > 0 {
+			cfg.JobTimeoutOverrides[strings.ToLower(resourceType)] = v
+		}
+	}
+	if v, err := time.ParseDuration(os.Getenv("WORKER_IDLE_TIMEOUT")); err == nil && v > 0 {
+		cfg.IdleTimeout = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("WORKER_ACK_WAIT")); err == nil && v > 0 {
+		cfg.AckWait = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("WORKER_NAK_BASE_DELAY")); err == nil && v > 0 {
+		cfg.NakBaseDelay = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("WORKER_NAK_MAX_DELAY")); err == nil && v > 0 {
+		cfg.NakMaxDelay = v
+	}
+	if v, err := strconv.ParseUint(os.Getenv("WORKER_MAX_DELIVERIES"), 10, 64); err == nil && v > 0 {
+		cfg.MaxDeliveries = v
+	}
+	if v, err := time.ParseDuration(os.Getenv("WORKER_SHUTDOWN_GRACE")); err == nil && v > 0 {
+		cfg.ShutdownGrace = v
+	}
+	return cfg
+}
+
+// JobTimeoutOf returns the time budget of a job of the given resource type.
+func (c WorkerConfig) JobTimeoutOf(resourceType string) time.Duration {
+	if v, ok := c.JobTimeoutOverrides[strings.ToLower(resourceType)]; ok {
+		return v
+	}
+	return c.JobTimeout
+}
+
+// HeartbeatInterval keeps a few heartbeats within every AckWait.
+func (c WorkerConfig) HeartbeatInterval() time.Duration {
+	return c.AckWait / 3
+}
+
+// NakDelay returns the redelivery delay of a job that failed on its n-th delivery.
+func (c WorkerConfig) NakDelay(numDelivered uint64) time.Duration {
+	d := c.NakBaseDelay
+	for i := uint64(1); i < numDelivered && d < c.NakMaxDelay; i++ {
+		d *= 2
+	}
+	return min(d, c.NakMaxDelay)
+}
@@ -0,0 +1,58 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/opengovern/og-describer-azure/provider/configs"
+	"time"
+)
+
+// DeadLetter is what is published for a job that won't be retried anymore.
+// Job holds the original message so it can be replayed as is, RawData is
+// used instead if the message isn't valid json.
+type DeadLetter struct {
+	Subject      string          `json:"subject"`
+	Error        string          `json:"error"`
+	Permanent    bool            `json:"permanent"`
+	NumDelivered uint64          `json:"numDelivered"`
+	FailedAt     time.Time       `json:"failedAt"`
+	Job          json.RawMessage `json:"job,omitempty"`
+	RawData      []byte          `json:"rawData,omitempty"`
+}
+
+func deadLetterTopic() string {
+	if ManualTriggers == "true" {
+		return configs.DeadLetterTopicManuals
+	}
+	return configs.DeadLetterTopic
+}
+
+func (w *Worker) deadLetter(ctx context.Context, msg jetstream.Msg, jobErr error, permanent bool) error {
+	dl := DeadLetter{
+		Subject:   msg.Subject(),
+		Error:     jobErr.Error(),
+		Permanent: permanent,
+		FailedAt:  time.Now().UTC(),
+	}
+	id := fmt.Sprintf("dead-letter-%d", time.Now().UnixNano())
+	if md, err := msg.Metadata(); err == nil {
+		dl.NumDelivered = md.NumDelivered
+		id = fmt.Sprintf("dead-letter-%s-%d", md.Stream, md.Sequence.Stream)
+	}
+	if json.Valid(msg.Data()) {
+		dl.Job = msg.Data()
+	} else {
+		dl.RawData = msg.Data()
+	}
+
+	data, err := json.Marshal(dl)
+	if err != nil {
+		return err
+	}
+	if _, err := w.jq.Produce(ctx, deadLetterTopic(), data, id); err != nil {
+		return err
+	}
+	return nil
+}
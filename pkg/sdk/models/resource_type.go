@@ -21,6 +21,10 @@ type ResourceType struct {
 	Annotations map[string]string
 	Labels      map[string]string
 	Tags        map[string][]string
+
+	// Redact lists the description paths masked before the resource is
+	// sent, on top of the worker wide denylist.
+	Redact []string
 }
 
 func (r ResourceType) GetIntegrationType() integration.Type {
@@ -4,18 +4,24 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/opengovern/og-describer-azure/pkg/describer"
+	"github.com/opengovern/og-describer-azure/pkg/tracing"
 	"github.com/opengovern/og-describer-azure/provider/configs"
+	azuredescriber "github.com/opengovern/og-describer-azure/provider/describer"
 	"os"
-	"runtime"
+	"sync"
 	"time"
 
+	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 
 	"github.com/opengovern/og-util/pkg/describe"
 	esSinkClient "github.com/opengovern/og-util/pkg/es/ingest/client"
 	"github.com/opengovern/og-util/pkg/jq"
 	"github.com/opengovern/og-util/pkg/opengovernance-es-sdk"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -25,6 +31,12 @@ type Worker struct {
 	jq       *jq.JobQueue
 
 	esSinkClient esSinkClient.EsSinkServiceClient
+	sinkConfig   describer.SinkConfig
+
+	config    WorkerConfig
+	admission *admission
+	held      chan struct{}
+	jobs      sync.WaitGroup
 }
 
 var (
@@ -51,10 +63,38 @@ func NewWorker(
 		logger.Error("failed to create stream", zap.Error(err))
 		return nil, err
 	}
+	if err := jq.Stream(ctx, configs.DeadLetterStreamName, "describe jobs that failed for good", []string{configs.DeadLetterTopic, configs.DeadLetterTopicManuals}, 100000); err != nil {
+		logger.Error("failed to create dead letter stream", zap.Error(err))
+		return nil, err
+	}
 
+	// the incremental describe state is shared by the workers
+	nc, err := nats.Connect(url)
+	if err != nil {
+		logger.Error("failed to connect to nats", zap.Error(err), zap.String("url", url))
+		return nil, err
+	}
+	js, err := jetstream.New(nc)
+	if err != nil {
+		logger.Error("failed to create jetstream client", zap.Error(err))
+		return nil, err
+	}
+	stateStore, err := describer.NewKVStateStore(ctx, js, configs.DescribeStateBucket)
+	if err != nil {
+		logger.Error("failed to create describe state store", zap.Error(err))
+		return nil, err
+	}
+	sinkConfig := describer.SinkConfigFromEnv()
+	sinkConfig.Incremental.Store = stateStore
+
+	config := WorkerConfigFromEnv()
 	w := &Worker{
-		logger: logger,
-		jq:     jq,
+		logger:     logger,
+		jq:         jq,
+		sinkConfig: sinkConfig,
+		config:     config,
+		admission:  newAdmission(config),
+		held:       make(chan struct{}, config.MaxHeldJobs),
 	}
 
 	return w, nil
@@ -62,6 +102,10 @@ func NewWorker(
 
 func (w *Worker) Run(ctx context.Context) error {
 	w.logger.Info("starting to consume")
+	// jobs outlive ctx so they can wrap up once the worker is told to stop
+	jobsCtx, cancelJobs := context.WithCancelCause(context.WithoutCancel(ctx))
+	defer cancelJobs(nil)
+
 	topic := configs.JobQueueTopic
 	consumer := configs.ConsumerGroup
 	if ManualTriggers == "true" {
@@ -73,27 +117,38 @@ func (w *Worker) Run(ctx context.Context) error {
 		AckPolicy:         jetstream.AckExplicitPolicy,
 		DeliverPolicy:     jetstream.DeliverAllPolicy,
 		MaxAckPending:     -1,
-		AckWait:           time.Minute * 30,
+		AckWait:           w.config.AckWait,
 		InactiveThreshold: time.Hour,
 	}, []jetstream.PullConsumeOpt{
-		jetstream.PullMaxMessages(1),
+		jetstream.PullMaxMessages(w.config.MaxConcurrentJobs),
 	}, func(msg jetstream.Msg) {
 		w.logger.Info("received a new job")
 
-		defer msg.Ack()
-
-		ctx, cancel := context.WithTimeoutCause(ctx, time.Minute*25, errors.New("describe worker timed out"))
-		defer cancel()
-
-		if err := w.ProcessMessage(ctx, msg); err != nil {
-			w.logger.Error("failed to process message", zap.Error(err))
-		}
-		err := msg.Ack()
-		if err != nil {
-			w.logger.Error("failed to ack message", zap.Error(err))
+		job := peekJob(msg)
+		// held and admitted jobs alike are waited for on shutdown
+		w.jobs.Add(1)
+		if w.admission.busy(job.IntegrationID) {
+			// a job of an integration at its cap is held, and kept alive,
+			// until one of the integration's jobs finishes. It's not put back
+			// as that would use up its deliveries, and it's not waited for
+			// here so the jobs of other integrations keep coming in.
+			select {
+			case w.held <- struct{}{}:
+			case <-ctx.Done():
+				w.jobs.Done()
+				if err := msg.Nak(); err != nil {
+					w.logger.Error("failed to nak message", zap.Error(err))
+				}
+				return
+			}
+			w.logger.Info("integration is busy, holding the job", zap.String("integrationID", job.IntegrationID))
+			go w.admitAndHandle(ctx, jobsCtx, msg, job, func() { <-w.held })
+			return
 		}
 
-		w.logger.Info("processing a job completed")
+		// the consumer hands messages over one at a time, blocking here holds
+		// back the next one until there is room for it
+		w.admitAndHandle(ctx, jobsCtx, msg, job, func() {})
 	})
 	if err != nil {
 		return err
@@ -104,22 +159,199 @@ func (w *Worker) Run(ctx context.Context) error {
 	<-ctx.Done()
 	consumeCtx.Drain()
 	consumeCtx.Stop()
+	w.shutdown(cancelJobs)
 
 	return nil
 }
 
-func (w *Worker) ProcessMessage(ctx context.Context, msg jetstream.Msg) error {
+// admitAndHandle waits for the job to be admitted and runs it. The job is
+// handled in the background once admitted, admitted is called right before.
+// It must be called with the job added to w.jobs, which it marks done.
+func (w *Worker) admitAndHandle(ctx, jobsCtx context.Context, msg jetstream.Msg, job describe.DescribeJob, admitted func()) {
+	waitCtx, stopWaiting := context.WithCancel(ctx)
+	go w.keepAlive(waitCtx, msg)
+	ok := w.admission.acquire(ctx, job.IntegrationID)
+	stopWaiting()
+	admitted()
+	if !ok {
+		// the worker is going away, let another one pick the job up right away
+		w.jobs.Done()
+		if err := msg.Nak(); err != nil {
+			w.logger.Error("failed to nak message", zap.Error(err))
+		}
+		return
+	}
+
+	go func() {
+		defer w.jobs.Done()
+		defer w.admission.release(job.IntegrationID)
+		w.handleMessage(ctx, jobsCtx, msg, job)
+	}()
+}
+
+// shutdown gives the running jobs half of ShutdownGrace to finish, then
+// cancels the ones left, they flush what they have described and report it
+// as failed, and waits the rest of the grace period for them.
+func (w *Worker) shutdown(cancelJobs context.CancelCauseFunc) {
+	w.logger.Info("shutting down", zap.Duration("grace", w.config.ShutdownGrace))
+	done := make(chan struct{})
+	go func() {
+		w.jobs.Wait()
+		close(done)
+	}()
+
+	finish := time.NewTimer(w.config.ShutdownGrace / 2)
+	defer finish.Stop()
+	select {
+	case <-done:
+		w.logger.Info("all jobs finished")
+		return
+	case <-finish.C:
+	}
+
+	w.logger.Info("cancelling the remaining jobs")
+	cancelJobs(describer.ErrWorkerShutdown)
+	report := time.NewTimer(w.config.ShutdownGrace - w.config.ShutdownGrace/2)
+	defer report.Stop()
+	select {
+	case <-done:
+		w.logger.Info("all jobs reported")
+	case <-report.C:
+		w.logger.Error("shutdown grace period is over, leaving the remaining jobs to be redelivered")
+	}
+}
+
+func (w *Worker) handleMessage(ctx, jobsCtx context.Context, msg jetstream.Msg, job describe.DescribeJob) {
+	jobCtx, cancel := context.WithTimeoutCause(jobsCtx, w.config.JobTimeoutOf(job.ResourceType), errors.New("describe worker timed out"))
+	defer cancel()
+
+	progress := azuredescriber.NewProgress()
+	jobCtx = azuredescriber.WithProgress(jobCtx, progress)
+	jobCtx, stopHeartbeat := context.WithCancelCause(jobCtx)
+	defer stopHeartbeat(nil)
+	go w.heartbeat(jobCtx, msg, job, progress, stopHeartbeat)
+
+	err := w.ProcessMessage(jobCtx, msg)
+	stopHeartbeat(nil)
+	if err == nil {
+		if err := msg.Ack(); err != nil {
+			w.logger.Error("failed to ack message", zap.Error(err))
+		}
+		w.logger.Info("processing a job completed")
+		return
+	}
+	w.logger.Error("failed to process message", zap.Error(err))
+	w.settleFailure(ctx, msg, job, err)
+}
+
+// settleFailure puts a failed job back with a growing delay, or dead letters
+// it once it failed permanently or ran out of deliveries.
+func (w *Worker) settleFailure(ctx context.Context, msg jetstream.Msg, job describe.DescribeJob, jobErr error) {
+	// the worker is going away, let another one pick the job up right away
+	if ctx.Err() != nil {
+		if err := msg.Nak(); err != nil {
+			w.logger.Error("failed to nak message", zap.Error(err))
+		}
+		return
+	}
+
+	var numDelivered uint64 = 1
+	if md, err := msg.Metadata(); err == nil {
+		numDelivered = md.NumDelivered
+	}
+
+	permanent := describer.IsPermanent(jobErr)
+	if !permanent && numDelivered < w.config.MaxDeliveries {
+		delay := w.config.NakDelay(numDelivered)
+		w.logger.Info("job failed, putting it back", zap.Uint("id", job.JobID), zap.Uint64("numDelivered", numDelivered), zap.Duration("delay", delay))
+		if err := msg.NakWithDelay(delay); err != nil {
+			w.logger.Error("failed to nak message", zap.Error(err))
+		}
+		return
+	}
+
+	w.logger.Error("job failed for good, dead lettering it", zap.Uint("id", job.JobID), zap.Uint64("numDelivered", numDelivered), zap.Bool("permanent", permanent), zap.Error(jobErr))
+	if err := w.deadLetter(ctx, msg, jobErr, permanent); err != nil {
+		// keep the job around rather than losing it
+		w.logger.Error("failed to dead letter job", zap.Error(err), zap.Uint("id", job.JobID))
+		if err := msg.NakWithDelay(w.config.NakMaxDelay); err != nil {
+			w.logger.Error("failed to nak message", zap.Error(err))
+		}
+		return
+	}
+	if err := msg.Term(); err != nil {
+		w.logger.Error("failed to terminate message", zap.Error(err))
+	}
+}
+
+// heartbeat tells NATS the job is still being worked on for as long as it
+// makes progress, and cancels it once it has been idle for IdleTimeout.
+func (w *Worker) heartbeat(ctx context.Context, msg jetstream.Msg, job describe.DescribeJob, progress *azuredescriber.Progress, cancel context.CancelCauseFunc) {
+	t := time.NewTicker(w.config.HeartbeatInterval())
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if idle := progress.Idle(); idle > w.config.IdleTimeout {
+				w.logger.Error("job stalled, cancelling it", zap.Uint("id", job.JobID), zap.String("type", job.ResourceType), zap.Duration("idle", idle))
+				cancel(fmt.Errorf("describe job made no progress for %s", idle.Round(time.Second)))
+				return
+			}
+			if err := msg.InProgress(); err != nil {
+				w.logger.Error("failed to send heartbeat", zap.Error(err), zap.Uint("id", job.JobID))
+			}
+		}
+	}
+}
+
+// keepAlive stops NATS from redelivering a job while it waits for admission.
+func (w *Worker) keepAlive(ctx context.Context, msg jetstream.Msg) {
+	t := time.NewTicker(w.config.HeartbeatInterval())
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := msg.InProgress(); err != nil {
+				w.logger.Error("failed to send heartbeat", zap.Error(err))
+			}
+		}
+	}
+}
+
+// peekJob reads the job out of the message ahead of ProcessMessage, which
+// parses it in full.
+func peekJob(msg jetstream.Msg) describe.DescribeJob {
+	var input describe.DescribeWorkerInput
+	if err := json.Unmarshal(msg.Data(), &input); err != nil {
+		return describe.DescribeJob{}
+	}
+	return input.DescribeJob
+}
+
+func (w *Worker) ProcessMessage(ctx context.Context, msg jetstream.Msg) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "Worker.ProcessMessage", trace.WithAttributes(
+		attribute.String("messaging.subject", msg.Subject()),
+	))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	startTime := time.Now()
 	var input describe.DescribeWorkerInput
-	err := json.Unmarshal(msg.Data(), &input)
+	err = json.Unmarshal(msg.Data(), &input)
 	if err != nil {
-		return err
+		return describer.Permanent(fmt.Errorf("failed to parse job: %w", err))
 	}
-	runtime.GC()
 
 	w.logger.Info("running job", zap.Uint("id", input.DescribeJob.JobID), zap.String("type", input.DescribeJob.ResourceType), zap.String("providerID", input.DescribeJob.ProviderID))
 
-	err = describer.DescribeHandler(ctx, w.logger, describer.TriggeredByLocal, input)
+	err = describer.DescribeHandler(ctx, w.logger, describer.TriggeredByLocal, input, w.sinkConfig)
 	endTime := time.Now()
 
 	w.logger.Info("job completed", zap.Uint("id", input.DescribeJob.JobID), zap.String("type", input.DescribeJob.ResourceType), zap.String("providerID", input.DescribeJob.ProviderID), zap.Duration("duration", endTime.Sub(startTime)))
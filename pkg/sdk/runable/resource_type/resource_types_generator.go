@@ -30,6 +30,20 @@ type ResourceType struct {
 	Labels            map[string]string
 	AnnotationsString string `json:"-"`
 	LabelsString      string `json:"-"`
+	Redact            []string
+	RedactString      string `json:"-"`
+	ResourceGraph     *ResourceGraph
+}
+
+// ResourceGraph lists the type through Resource Graph instead of
+// ListDescriber.
+type ResourceGraph struct {
+	// Table is the Resource Graph table of the type, Resources by default.
+	Table string
+	// Hydrate is a describer.ResourceGraphHydrator that completes the rows
+	// with ARM calls, the rows are decoded into the Model description if
+	// it's empty.
+	Hydrate string
 }
 
 var (
@@ -57,7 +71,8 @@ func main() {
 		Labels:               {{ .LabelsString }},
 		Annotations:          {{ .AnnotationsString }},
 		ListDescriber:        {{ .ListDescriber }},
-		GetDescriber:         {{ if .GetDescriber }}{{ .GetDescriber }}{{ else }}nil{{ end }},
+		GetDescriber:         {{ if .GetDescriber }}{{ .GetDescriber }}{{ else }}nil{{ end }},{{ if .RedactString }}
+		Redact:               {{ .RedactString }},{{ end }}
 	},
 `))
 	if err != nil {
@@ -80,16 +95,24 @@ func main() {
 		resourceTypesList = &v
 	}
 
+	// the rows decoded without hydration need the description models
+	modelImport := ""
+	for _, resourceType := range resourceTypes {
+		if resourceType.ResourceGraph != nil && resourceType.ResourceGraph.Hydrate == "" {
+			modelImport = fmt.Sprintf("\n\tazuremodel \"%s/provider/model\"", configs.OGPluginRepoURL)
+		}
+	}
+
 	// Initialize a strings.Builder to construct the output file content
 	b := &strings.Builder{}
 	b.WriteString(fmt.Sprintf(`package provider
 import (
 	"%[1]s/provider/describer"
-	"%[1]s/provider/configs"
+	"%[1]s/provider/configs"%[2]s
 	model "github.com/opengovern/og-describer-azure/pkg/sdk/models"
 )
 var ResourceTypes = map[string]model.ResourceType{
-`, configs.OGPluginRepoURL))
+`, configs.OGPluginRepoURL, modelImport))
 
 	// Iterate over each resource type to build its string representations
 	for _, resourceType := range resourceTypes {
@@ -156,6 +179,29 @@ var ResourceTypes = map[string]model.ResourceType{
 		annotationsStringBuilder.WriteString("        }")
 		resourceType.AnnotationsString = annotationsStringBuilder.String()
 
+		// Build RedactString
+		if len(resourceType.Redact) > 0 {
+			arr = []string{}
+			for _, r := range resourceType.Redact {
+				arr = append(arr, "\""+escapeString(r)+"\"")
+			}
+			resourceType.RedactString = fmt.Sprintf("[]string{%s}", strings.Join(arr, ", "))
+		}
+
+		// Build the Resource Graph ListDescriber
+		if rg := resourceType.ResourceGraph; rg != nil {
+			table := rg.Table
+			if table == "" {
+				table = "Resources"
+			}
+			describe := rg.Hydrate
+			if describe == "" {
+				describe = fmt.Sprintf("describer.ResourceGraphDescription[azuremodel.%sDescription]", resourceType.Model)
+			}
+			graph := fmt.Sprintf("describer.GenericResourceGraph{Table: \"%s\", Type: \"%s\", Describe: %s}", table, resourceType.ResourceName, describe)
+			resourceType.ListDescriber = fmt.Sprintf("DescribeByResourceGraph(%s)", graph)
+		}
+
 		// Execute the template with the current resourceType
 		err = tmpl.Execute(b, resourceType)
 		if err != nil {
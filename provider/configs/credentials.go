@@ -2,6 +2,48 @@ package configs
 
 import "github.com/opengovern/opengovernance/services/integration/integration-type/azure-subscription/configs"
 
+type CredentialType string
+
+const (
+	CredentialTypeClientSecret      CredentialType = "client_secret"
+	CredentialTypeClientCertificate CredentialType = "client_certificate"
+	CredentialTypeManagedIdentity   CredentialType = "managed_identity"
+	CredentialTypeWorkloadIdentity  CredentialType = "workload_identity"
+	// CredentialTypeFederated exchanges the kubernetes service account token
+	// of the worker for a token of a multi-tenant app in the tenant of the
+	// integration.
+	CredentialTypeFederated CredentialType = "federated"
+)
+
 type IntegrationCredentials struct {
 	configs.IntegrationCredentials
+
+	// CredentialType selects the credential, when empty it is a client
+	// certificate if one is set and a client secret otherwise.
+	CredentialType CredentialType `json:"credential_type,omitempty"`
+
+	// Cloud is the Azure cloud of the tenant, e.g. AzureUSGovernment or
+	// AzureChinaCloud, the public cloud when empty.
+	Cloud string `json:"cloud,omitempty"`
+
+	// Certificate is a PEM or PFX client certificate, used instead of
+	// ClientPassword when set. Binary PFX is base64 encoded.
+	Certificate         string `json:"certificate,omitempty"`
+	CertificatePassword string `json:"certificate_password,omitempty"`
+
+	// FederatedTokenFile is the service account token used by workload
+	// identity and federated credentials, defaults to AZURE_FEDERATED_TOKEN_FILE.
+	FederatedTokenFile string `json:"federated_token_file,omitempty"`
+}
+
+// Type returns the credential type, inferred from the set fields if it's
+// not given.
+func (c IntegrationCredentials) Type() CredentialType {
+	if c.CredentialType != "" {
+		return c.CredentialType
+	}
+	if c.Certificate != "" {
+		return CredentialTypeClientCertificate
+	}
+	return CredentialTypeClientSecret
 }
@@ -8,4 +8,10 @@ const (
 	ConsumerGroup        = configs.ConsumerGroup
 	JobQueueTopicManuals = configs.JobQueueTopicManuals
 	ConsumerGroupManuals = configs.ConsumerGroupManuals
+
+	DeadLetterStreamName   = StreamName + "-dead-letter"
+	DeadLetterTopic        = JobQueueTopic + "-dead-letter"
+	DeadLetterTopicManuals = JobQueueTopicManuals + "-dead-letter"
+
+	DescribeStateBucket = StreamName + "-describe-state"
 )
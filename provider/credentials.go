@@ -5,6 +5,7 @@ import (
 	"fmt"
 	model "github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"github.com/opengovern/og-describer-azure/provider/configs"
+	"github.com/opengovern/og-describer-azure/provider/describer"
 	azuremodel "github.com/opengovern/og-describer-azure/provider/model"
 	"github.com/opengovern/og-util/pkg/describe"
 	"strings"
@@ -22,18 +23,40 @@ func AccountCredentialsFromMap(m map[string]any) (configs.IntegrationCredentials
 		return configs.IntegrationCredentials{}, err
 	}
 
+	switch c.Type() {
+	case configs.CredentialTypeClientSecret, configs.CredentialTypeClientCertificate,
+		configs.CredentialTypeManagedIdentity, configs.CredentialTypeWorkloadIdentity,
+		configs.CredentialTypeFederated:
+	default:
+		return configs.IntegrationCredentials{}, fmt.Errorf("unknown credential type %s", c.CredentialType)
+	}
+	if _, err := describer.CloudByName(c.Cloud); err != nil {
+		return configs.IntegrationCredentials{}, err
+	}
+
 	return c, nil
 }
 
+// accountInfoOf returns what the describer recorded on the resource under
+// key, e.g. its subscription in a tenant scoped job.
+func accountInfoOf(resource model.Resource, key, fallback string) string {
+	if info, ok := resource.AccountInfo.(map[string]string); ok && info[key] != "" {
+		return info[key]
+	}
+	return fallback
+}
+
 func GetResourceMetadata(job describe.DescribeJob, resource model.Resource) (map[string]string, error) {
 	azureMetadata := azuremodel.Metadata{
 		ID:               resource.ID,
 		Name:             resource.Name,
-		SubscriptionID:   job.ProviderID,
+		SubscriptionID:   accountInfoOf(resource, "SubscriptionID", job.ProviderID),
 		Location:         resource.Location,
-		CloudEnvironment: "AzurePublicCloud",
+		CloudEnvironment: accountInfoOf(resource, "CloudEnvironment", describer.AzurePublicCloud.Name),
 		ResourceType:     strings.ToLower(job.ResourceType),
 		IntegrationID:    job.IntegrationID,
+
+		ManagementGroupPath: accountInfoOf(resource, "ManagementGroupPath", ""),
 	}
 	azureMetadataBytes, err := json.Marshal(azureMetadata)
 	if err != nil {
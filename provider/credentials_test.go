@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/opengovern/og-describer-azure/provider/configs"
+)
+
+func TestAccountCredentialsFromMapType(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]any
+		want    configs.CredentialType
+		wantErr bool
+	}{
+		{
+			name:   "ClientSecret",
+			config: map[string]any{"tenant_id": "t", "client_id": "c", "client_password": "p"},
+			want:   configs.CredentialTypeClientSecret,
+		},
+		{
+			name:   "InferredCertificate",
+			config: map[string]any{"tenant_id": "t", "client_id": "c", "certificate": "cert"},
+			want:   configs.CredentialTypeClientCertificate,
+		},
+		{
+			name:   "ManagedIdentity",
+			config: map[string]any{"credential_type": "managed_identity"},
+			want:   configs.CredentialTypeManagedIdentity,
+		},
+		{
+			name:   "GovernmentCloud",
+			config: map[string]any{"client_password": "p", "cloud": "AzureUSGovernment"},
+			want:   configs.CredentialTypeClientSecret,
+		},
+		{
+			name:    "UnknownCloud",
+			config:  map[string]any{"client_password": "p", "cloud": "mars"},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown",
+			config:  map[string]any{"credential_type": "password"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := AccountCredentialsFromMap(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AccountCredentialsFromMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && c.Type() != tt.want {
+				t.Errorf("Type() = %s, want %s", c.Type(), tt.want)
+			}
+		})
+	}
+}
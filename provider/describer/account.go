@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/datalake-analytics/armdatalakeanalytics"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/datalake-store/armdatalakestore"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
@@ -12,14 +13,14 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func DataLakeAnalyticsAccount(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armdatalakeanalytics.NewClientFactory(subscription, cred, nil)
+func DataLakeAnalyticsAccount(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armdatalakeanalytics.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewAccountsClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +73,9 @@ func getDataLakeAnalyticsAccount(ctx context.Context, account *armdatalakeanalyt
 	for pager.More() {
 		accountOpPage, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *account.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		for _, accountOp := range accountOpPage.Value {
@@ -94,14 +98,36 @@ func getDataLakeAnalyticsAccount(ctx context.Context, account *armdatalakeanalyt
 	return &resource, nil
 }
 
-func DataLakeStore(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armdatalakestore.NewClientFactory(subscription, cred, nil)
+// DataLakeAnalyticsAccountByID describes the Data Lake Analytics account with
+// the ARM id.
+func DataLakeAnalyticsAccountByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdatalakeanalytics.NewAccountsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resource, err := getDataLakeAnalyticsAccount(ctx, &armdatalakeanalytics.AccountBasic{ID: &resourceID, Name: &id.Name}, client, monitorClientFactory.NewDiagnosticSettingsClient())
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return resource, nil
+}
+
+func DataLakeStore(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armdatalakestore.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewAccountsClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -152,6 +178,9 @@ func getDataLakeStore(ctx context.Context, account *armdatalakestore.AccountBasi
 	for accountListOpTemp.More() {
 		accountOpPage, err := accountListOpTemp.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *account.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		for _, accountOp := range accountOpPage.Value {
@@ -172,3 +201,24 @@ func getDataLakeStore(ctx context.Context, account *armdatalakestore.AccountBasi
 	}
 	return &resource, nil
 }
+
+// DataLakeStoreByID describes the Data Lake Store account with the ARM id.
+func DataLakeStoreByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdatalakestore.NewAccountsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resource, err := getDataLakeStore(ctx, &armdatalakestore.AccountBasic{ID: &resourceID, Name: &id.Name}, monitorClientFactory.NewDiagnosticSettingsClient(), client)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return resource, nil
+}
@@ -5,14 +5,14 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/alertsmanagement/armalertsmanagement"
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func AlertManagement(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func AlertManagement(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 
-	clientFactory, err := armalertsmanagement.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armalertsmanagement.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
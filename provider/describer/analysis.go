@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/analysisservices/armanalysisservices"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func AnalysisService(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armanalysisservices.NewClientFactory(subscription, cred, nil)
+func AnalysisService(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armanalysisservices.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -54,3 +55,20 @@ func getAnalysisService(ctx context.Context, server *armanalysisservices.Server)
 	}
 	return &resource
 }
+
+// AnalysisServiceByID describes the Analysis Services server with the ARM id.
+func AnalysisServiceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armanalysisservices.NewServersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetDetails(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getAnalysisService(ctx, &resp.Server), nil
+}
@@ -5,21 +5,22 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/apimanagement/armapimanagement"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func APIManagement(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armapimanagement.NewClientFactory(subscription, cred, nil)
+func APIManagement(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armapimanagement.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewServiceClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +57,9 @@ func getAPIMangement(ctx context.Context, diagnosticClient *armmonitor.Diagnosti
 	for accountListOpTemp.More() {
 		accountOpPage, err := accountListOpTemp.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *apiManagement.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		for _, accountOp := range accountOpPage.Value {
@@ -77,9 +81,31 @@ func getAPIMangement(ctx context.Context, diagnosticClient *armmonitor.Diagnosti
 	return &resource, nil
 }
 
-func APIManagementBackend(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+// APIManagementByID describes the API Management service with the ARM id.
+func APIManagementByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armapimanagement.NewServiceClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getAPIMangement(ctx, diagnosticClient, &resp.ServiceResource)
+}
+
+func APIManagementBackend(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 
-	clientFactory, err := armapimanagement.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armapimanagement.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -151,3 +177,28 @@ func GetAPIManagementBackend(ctx context.Context, service *armapimanagement.Serv
 	}
 	return &resource
 }
+
+// APIManagementBackendByID describes the API Management backend with the ARM id.
+func APIManagementBackendByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armapimanagement.NewBackendClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armapimanagement.NewServiceClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	service, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetAPIManagementBackend(ctx, &service.ServiceResource, &resp.BackendContract), nil
+}
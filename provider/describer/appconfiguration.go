@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appconfiguration/armappconfiguration"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,14 +12,14 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func AppConfiguration(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armappconfiguration.NewClientFactory(subscription, cred, nil)
+func AppConfiguration(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armappconfiguration.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewConfigurationStoresClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +57,9 @@ func getAppConfiguration(ctx context.Context, diagnosticClient *armmonitor.Diagn
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *config.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		for _, config := range page.Value {
@@ -76,3 +80,25 @@ func getAppConfiguration(ctx context.Context, diagnosticClient *armmonitor.Diagn
 	}
 	return &resource, nil
 }
+
+// AppConfigurationByID describes the App Configuration store with the ARM id.
+func AppConfigurationByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armappconfiguration.NewConfigurationStoresClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getAppConfiguration(ctx, diagnosticClient, &resp.ConfigurationStore)
+}
@@ -1,7 +1,8 @@
 package describer
 
 import (
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/applicationinsights/armapplicationinsights"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"github.com/opengovern/og-describer-azure/provider/model"
@@ -9,8 +10,8 @@ import (
 	"strings"
 )
 
-func ApplicationInsights(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armapplicationinsights.NewClientFactory(subscription, cred, nil)
+func ApplicationInsights(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armapplicationinsights.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -53,3 +54,20 @@ func GetApplicationInsights(ctx context.Context, component *armapplicationinsigh
 	}
 	return &resource
 }
+
+// ApplicationInsightsByID describes the Application Insights component with the ARM id.
+func ApplicationInsightsByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armapplicationinsights.NewComponentsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetApplicationInsights(ctx, &resp.Component), nil
+}
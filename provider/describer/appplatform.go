@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/springappdiscovery/armspringappdiscovery"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,10 +11,10 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func SpringCloudService(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func SpringCloudService(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 	var values []models.Resource
 
-	clientFactory, err := armspringappdiscovery.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armspringappdiscovery.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -80,3 +81,28 @@ func getSpringCloudService(ctx context.Context, service *armspringappdiscovery.S
 	}
 	return &resource, nil
 }
+
+// SpringCloudServiceByID describes the Spring Boot app with the ARM id.
+func SpringCloudServiceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armspringappdiscovery.NewSpringbootappsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armspringappdiscovery.NewSpringbootsitesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	site, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getSpringCloudService(ctx, &resp.SpringbootappsModel, &site.SpringbootsitesModel)
+}
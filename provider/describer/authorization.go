@@ -3,11 +3,11 @@ package describer
 import (
 	"context"
 	"fmt"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
 	"github.com/aws/aws-sdk-go-v2/aws"
-	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 	"github.com/microsoftgraph/msgraph-sdk-go/groups"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"regexp"
@@ -16,8 +16,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func RoleAssignment(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armauthorization.NewRoleAssignmentsClient(subscription, cred, nil)
+func RoleAssignment(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armauthorization.NewRoleAssignmentsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +42,19 @@ func RoleAssignment(ctx context.Context, cred *azidentity.ClientSecretCredential
 	return values, nil
 }
 
+// RoleAssignmentByID describes the role assignment with the ARM id.
+func RoleAssignmentByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	client, err := armauthorization.NewRoleAssignmentsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetByID(ctx, resourceID, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getRoleAssignment(ctx, &resp.RoleAssignment), nil
+}
+
 func getRoleAssignment(ctx context.Context, v *armauthorization.RoleAssignment) *models.Resource {
 	return &models.Resource{
 		ID:       *v.ID,
@@ -55,8 +68,8 @@ func getRoleAssignment(ctx context.Context, v *armauthorization.RoleAssignment)
 	}
 }
 
-func RoleDefinition(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armauthorization.NewRoleDefinitionsClient(cred, nil)
+func RoleDefinition(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armauthorization.NewRoleDefinitionsClient(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +95,19 @@ func RoleDefinition(ctx context.Context, cred *azidentity.ClientSecretCredential
 	return values, nil
 }
 
+// RoleDefinitionByID describes the role definition with the ARM id.
+func RoleDefinitionByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	client, err := armauthorization.NewRoleDefinitionsClient(cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetByID(ctx, resourceID, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getRoleDefinition(ctx, &resp.RoleDefinition), nil
+}
+
 func getRoleDefinition(ctx context.Context, v *armauthorization.RoleDefinition) *models.Resource {
 	return &models.Resource{
 		ID:       *v.ID,
@@ -95,8 +121,8 @@ func getRoleDefinition(ctx context.Context, v *armauthorization.RoleDefinition)
 	}
 }
 
-func PolicyDefinition(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armpolicy.NewClientFactory(subscription, cred, nil)
+func PolicyDefinition(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armpolicy.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +148,23 @@ func PolicyDefinition(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// PolicyDefinitionByID describes the policy definition with the ARM id.
+func PolicyDefinitionByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armpolicy.NewDefinitionsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getPolicyDefinition(ctx, subscription, &resp.Definition), nil
+}
+
 func getPolicyDefinition(ctx context.Context, subscription string, definition *armpolicy.Definition) *models.Resource {
 	akas := []string{"azure:///subscriptions/" + subscription + *definition.ID, "azure:///subscriptions/" + subscription + strings.ToLower(*definition.ID)}
 	turbotData := map[string]interface{}{
@@ -142,14 +185,13 @@ func getPolicyDefinition(ctx context.Context, subscription string, definition *a
 	}
 }
 
-func UserEffectiveAccess(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armauthorization.NewRoleAssignmentsClient(subscription, cred, nil)
+func UserEffectiveAccess(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armauthorization.NewRoleAssignmentsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	pager := client.NewListForSubscriptionPager(nil)
-	scopes := []string{"https://graph.microsoft.com/.default"}
-	graphClient, err := msgraphsdk.NewGraphServiceClientWithCredentials(cred, scopes)
+	graphClient, err := newGraphClient(ctx, cred)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client: %v", err)
 	}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/automation/armautomation"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func AutomationAccounts(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armautomation.NewClientFactory(subscription, cred, nil)
+func AutomationAccounts(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armautomation.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -57,8 +58,25 @@ func getAutomationAccount(ctx context.Context, account *armautomation.Account) *
 	return &resource
 }
 
-func AutomationVariables(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armautomation.NewClientFactory(subscription, cred, nil)
+// AutomationAccountByID describes the Automation account with the ARM id.
+func AutomationAccountByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armautomation.NewAccountClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getAutomationAccount(ctx, &resp.Account), nil
+}
+
+func AutomationVariables(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armautomation.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -126,3 +144,28 @@ func GetAutomationVariable(ctx context.Context, account *armautomation.Account,
 	}
 	return &resource
 }
+
+// AutomationVariableByID describes the Automation variable with the ARM id.
+func AutomationVariableByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armautomation.NewVariableClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armautomation.NewAccountClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetAutomationVariable(ctx, &account.Account, &resp.Variable), nil
+}
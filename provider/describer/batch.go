@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/batch/armbatch"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,14 +12,14 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func BatchAccount(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armbatch.NewClientFactory(subscription, cred, nil)
+func BatchAccount(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armbatch.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewAccountClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +59,9 @@ func getBatchAccount(ctx context.Context, account *armbatch.Account, diagnosticC
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, id, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		for _, item := range page.Value {
@@ -81,3 +85,25 @@ func getBatchAccount(ctx context.Context, account *armbatch.Account, diagnosticC
 	}
 	return &resource, nil
 }
+
+// BatchAccountByID describes the Batch account with the ARM id.
+func BatchAccountByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armbatch.NewAccountClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getBatchAccount(ctx, &resp.Account, diagnosticClient)
+}
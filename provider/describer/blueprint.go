@@ -3,15 +3,16 @@ package describer
 import (
 	"context"
 	"fmt"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/blueprint/armblueprint"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"github.com/opengovern/og-describer-azure/provider/model"
 	"strings"
 )
 
-func BlueprintArtifact(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armblueprint.NewClientFactory(cred, nil)
+func BlueprintArtifact(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armblueprint.NewClientFactory(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +62,8 @@ func getBluePrintArtifact(ctx context.Context, v armblueprint.ArtifactClassifica
 	}
 }
 
-func BlueprintBlueprint(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armblueprint.NewClientFactory(cred, nil)
+func BlueprintBlueprint(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armblueprint.NewClientFactory(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -100,3 +101,20 @@ func getBlueprintBlueprint(ctx context.Context, blueprint *armblueprint.Blueprin
 		}},
 	}
 }
+
+// BlueprintBlueprintByID describes the blueprint with the ARM id.
+func BlueprintBlueprintByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armblueprint.NewBlueprintsClient(cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, fmt.Sprintf("/subscriptions/%s", id.SubscriptionID), id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getBlueprintBlueprint(ctx, &resp.Blueprint), nil
+}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/botservice/armbotservice"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func BotServiceBot(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armbotservice.NewClientFactory(subscription, cred, nil)
+func BotServiceBot(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armbotservice.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -49,3 +50,20 @@ func getBotServiceBot(ctx context.Context, bot *armbotservice.Bot) *models.Resou
 		}},
 	}
 }
+
+// BotServiceBotByID describes the bot with the ARM id.
+func BotServiceBotByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armbotservice.NewBotsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getBotServiceBot(ctx, &resp.Bot), nil
+}
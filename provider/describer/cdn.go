@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/cdn/armcdn"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func CdnProfiles(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcdn.NewClientFactory(subscription, cred, nil)
+func CdnProfiles(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcdn.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -55,8 +56,25 @@ func getCdnProfiles(ctx context.Context, v *armcdn.Profile) *models.Resource {
 	return &resource
 }
 
-func CdnEndpoint(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcdn.NewClientFactory(subscription, cred, nil)
+// CdnProfileByID describes the CDN profile with the ARM id.
+func CdnProfileByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcdn.NewProfilesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getCdnProfiles(ctx, &resp.Profile), nil
+}
+
+func CdnEndpoint(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcdn.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -122,3 +140,28 @@ func getCdnEndpoint(ctx context.Context, v *armcdn.Profile, endpoint *armcdn.End
 		},
 	}
 }
+
+// CdnEndpointByID describes the CDN endpoint with the ARM id.
+func CdnEndpointByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcdn.NewEndpointsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armcdn.NewProfilesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	profile, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getCdnEndpoint(ctx, &profile.Profile, &resp.Endpoint, id.ResourceGroupName), nil
+}
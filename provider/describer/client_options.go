@@ -0,0 +1,99 @@
+package describer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/opengovern/og-describer-azure/pkg/metrics"
+	"github.com/opengovern/og-describer-azure/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// clientOptions returns the options every ARM client of a job is created with.
+func clientOptions(ctx context.Context) *arm.ClientOptions {
+	return &arm.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Cloud:            GetCloudFromContext(ctx).Configuration,
+			PerRetryPolicies: []policy.Policy{tracingPolicy{}, throttlePolicy{}, metricsPolicy{}, progressPolicy{}},
+		},
+	}
+}
+
+// progressPolicy counts every response from Azure as progress of the job, so
+// a describer that makes many calls before it streams anything isn't taken
+// for a stalled one.
+type progressPolicy struct{}
+
+func (progressPolicy) Do(req *policy.Request) (*http.Response, error) {
+	resp, err := req.Next()
+	if err == nil {
+		GetProgressFromContext(req.Raw().Context()).Touch()
+	}
+	return resp, err
+}
+
+// metricsPolicy counts every request sent to Azure, retries included.
+type metricsPolicy struct{}
+
+func (metricsPolicy) Do(req *policy.Request) (*http.Response, error) {
+	provider := resourceProviderOf(req.Raw().URL.Path)
+	resp, err := req.Next()
+	if err != nil {
+		metrics.AzureAPICalls.WithLabelValues(provider, "error").Inc()
+		return resp, err
+	}
+	metrics.AzureAPICalls.WithLabelValues(provider, strconv.Itoa(resp.StatusCode)).Inc()
+	if resp.StatusCode == http.StatusTooManyRequests {
+		metrics.AzureAPIThrottled.WithLabelValues(provider).Inc()
+	}
+	return resp, nil
+}
+
+// tracingPolicy wraps every request sent to Azure, retries included, in a span.
+type tracingPolicy struct{}
+
+func (tracingPolicy) Do(req *policy.Request) (*http.Response, error) {
+	raw := req.Raw()
+	ctx, span := tracing.Tracer().Start(raw.Context(), "Azure "+raw.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("http.request.method", raw.Method),
+		attribute.String("server.address", raw.URL.Host),
+		attribute.String("url.path", raw.URL.Path),
+		attribute.String("azure.resource_provider", resourceProviderOf(raw.URL.Path)),
+	))
+	req = req.WithContext(ctx)
+	resp, err := req.Next()
+	if err != nil {
+		tracing.End(span, err)
+		return resp, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if id := resp.Header.Get("x-ms-request-id"); id != "" {
+		span.SetAttributes(attribute.String("azure.request_id", id))
+	}
+	var statusErr error
+	if resp.StatusCode >= 400 {
+		statusErr = fmt.Errorf("azure responded with %s", resp.Status)
+	}
+	tracing.End(span, statusErr)
+	return resp, nil
+}
+
+// resourceProviderOf returns the last resource provider in an ARM path,
+// e.g. microsoft.compute for /subscriptions/.../providers/Microsoft.Compute/disks.
+func resourceProviderOf(path string) string {
+	parts := strings.Split(strings.ToLower(path), "/")
+	provider := "none"
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "providers" {
+			provider = parts[i+1]
+		}
+	}
+	return provider
+}
@@ -0,0 +1,96 @@
+package describer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/go-autorest/autorest/azure"
+	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
+)
+
+var (
+	cloudKey string = "cloud"
+)
+
+// Cloud is the Azure cloud an integration lives in.
+type Cloud struct {
+	// Name is what's recorded as the cloud environment of the resources.
+	Name          string
+	Configuration cloud.Configuration
+	GraphEndpoint string
+	StorageSuffix string
+}
+
+var (
+	AzurePublicCloud = Cloud{
+		Name:          "AzurePublicCloud",
+		Configuration: cloud.AzurePublic,
+		GraphEndpoint: "https://graph.microsoft.com",
+		StorageSuffix: "core.windows.net",
+	}
+	AzureUSGovernment = Cloud{
+		Name:          "AzureUSGovernment",
+		Configuration: cloud.AzureGovernment,
+		GraphEndpoint: "https://graph.microsoft.us",
+		StorageSuffix: "core.usgovcloudapi.net",
+	}
+	AzureChinaCloud = Cloud{
+		Name:          "AzureChinaCloud",
+		Configuration: cloud.AzureChina,
+		GraphEndpoint: "https://microsoftgraph.chinacloudapi.cn",
+		StorageSuffix: "core.chinacloudapi.cn",
+	}
+)
+
+// CloudByName looks a cloud up by its name or a short alias, case-insensitive.
+// An empty name is the public cloud.
+func CloudByName(name string) (Cloud, error) {
+	switch strings.ToLower(name) {
+	case "", "public", "azurecloud", "azurepubliccloud":
+		return AzurePublicCloud, nil
+	case "usgovernment", "azureusgovernment", "azureusgovernmentcloud":
+		return AzureUSGovernment, nil
+	case "china", "azurechinacloud":
+		return AzureChinaCloud, nil
+	}
+	return Cloud{}, fmt.Errorf("unknown cloud %s", name)
+}
+
+func WithCloud(ctx context.Context, c Cloud) context.Context {
+	return context.WithValue(ctx, cloudKey, c)
+}
+
+// GetCloudFromContext returns the cloud of the job, the public cloud if none
+// is set.
+func GetCloudFromContext(ctx context.Context) Cloud {
+	c, ok := ctx.Value(cloudKey).(Cloud)
+	if !ok {
+		return AzurePublicCloud
+	}
+	return c
+}
+
+// storageURL is the data plane endpoint of a storage account service, e.g.
+// blob or table.
+func storageURL(ctx context.Context, accountName, service string) string {
+	return fmt.Sprintf("https://%s.%s.%s", accountName, service, GetCloudFromContext(ctx).StorageSuffix)
+}
+
+// storageEnvironment is the environment the autorest storage clients need.
+func storageEnvironment(ctx context.Context) azure.Environment {
+	return azure.Environment{StorageEndpointSuffix: GetCloudFromContext(ctx).StorageSuffix}
+}
+
+// newGraphClient creates a Graph client for the cloud of the job.
+func newGraphClient(ctx context.Context, cred azcore.TokenCredential) (*msgraphsdk.GraphServiceClient, error) {
+	c := GetCloudFromContext(ctx)
+	client, err := msgraphsdk.NewGraphServiceClientWithCredentials(cred, []string{c.GraphEndpoint + "/.default"})
+	if err != nil {
+		return nil, err
+	}
+	client.GetAdapter().SetBaseUrl(c.GraphEndpoint + "/v1.0")
+	return client, nil
+}
@@ -7,7 +7,9 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/guestconfiguration/armguestconfiguration"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
@@ -16,8 +18,8 @@ import (
 	"github.com/turbot/go-kit/types"
 )
 
-func ComputeDisk(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeDisk(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +46,23 @@ func ComputeDisk(ctx context.Context, cred *azidentity.ClientSecretCredential, s
 	return values, nil
 }
 
+// ComputeDiskByID describes the managed disk with the ARM id.
+func ComputeDiskByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewDisksClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeDisk(ctx, &resp.Disk), nil
+}
+
 func getComputeDisk(ctx context.Context, v *armcompute.Disk) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -60,8 +79,8 @@ func getComputeDisk(ctx context.Context, v *armcompute.Disk) *models.Resource {
 	}
 }
 
-func ComputeDiskAccess(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeDiskAccess(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +107,23 @@ func ComputeDiskAccess(ctx context.Context, cred *azidentity.ClientSecretCredent
 	return values, nil
 }
 
+// ComputeDiskAccessByID describes the disk access with the ARM id.
+func ComputeDiskAccessByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewDiskAccessesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeDiskAccess(ctx, &resp.DiskAccess), nil
+}
+
 func getComputeDiskAccess(ctx context.Context, v *armcompute.DiskAccess) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -104,8 +140,8 @@ func getComputeDiskAccess(ctx context.Context, v *armcompute.DiskAccess) *models
 	}
 }
 
-func ComputeVirtualMachineScaleSet(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeVirtualMachineScaleSet(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +172,23 @@ func ComputeVirtualMachineScaleSet(ctx context.Context, cred *azidentity.ClientS
 	return values, nil
 }
 
+// ComputeVirtualMachineScaleSetByID describes the scale set with the ARM id.
+func ComputeVirtualMachineScaleSetByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewVirtualMachineScaleSetsClient().Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeVirtualMachineScaleSet(ctx, clientFactory.NewVirtualMachineScaleSetExtensionsClient(), &resp.VirtualMachineScaleSet)
+}
+
 func getComputeVirtualMachineScaleSet(ctx context.Context, clientExtension *armcompute.VirtualMachineScaleSetExtensionsClient, v *armcompute.VirtualMachineScaleSet) (*models.Resource, error) {
 	resourceGroupName := strings.Split(*v.ID, "/")[4]
 
@@ -166,14 +219,14 @@ func getComputeVirtualMachineScaleSet(ctx context.Context, clientExtension *armc
 	return &resource, nil
 }
 
-func ComputeVirtualMachineScaleSetNetworkInterface(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeVirtualMachineScaleSetNetworkInterface(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewVirtualMachineScaleSetsClient()
 
-	networkClient, err := armnetwork.NewInterfacesClient(subscription, cred, nil)
+	networkClient, err := armnetwork.NewInterfacesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +267,36 @@ func ComputeVirtualMachineScaleSetNetworkInterface(ctx context.Context, cred *az
 	return values, nil
 }
 
+// ComputeVirtualMachineScaleSetNetworkInterfaceByID describes the scale set
+// network interface with the ARM id.
+func ComputeVirtualMachineScaleSetNetworkInterfaceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	// .../virtualMachineScaleSets/{scaleSet}/virtualMachines/{index}/networkInterfaces/{name}
+	if id.Parent == nil || id.Parent.Parent == nil {
+		return nil, fmt.Errorf("invalid scale set network interface id %s", resourceID)
+	}
+	networkClient, err := armnetwork.NewInterfacesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	nic, err := networkClient.GetVirtualMachineScaleSetNetworkInterface(ctx, id.ResourceGroupName, id.Parent.Parent.Name, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	client, err := armcompute.NewVirtualMachineScaleSetsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	vm, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeVirtualMachineScaleSetNetworkInterface(ctx, &vm.VirtualMachineScaleSet, &nic.Interface), nil
+}
+
 func getComputeVirtualMachineScaleSetNetworkInterface(ctx context.Context, vm *armcompute.VirtualMachineScaleSet, v *armnetwork.Interface) *models.Resource {
 	resourceGroupName := strings.Split(*v.ID, "/")[4]
 	resource := models.Resource{
@@ -231,8 +314,8 @@ func getComputeVirtualMachineScaleSetNetworkInterface(ctx context.Context, vm *a
 	return &resource
 }
 
-func ComputeVirtualMachineScaleSetVm(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeVirtualMachineScaleSetVm(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -270,6 +353,33 @@ func ComputeVirtualMachineScaleSetVm(ctx context.Context, cred *azidentity.Clien
 	return values, nil
 }
 
+// ComputeVirtualMachineScaleSetVmByID describes the scale set instance with
+// the ARM id.
+func ComputeVirtualMachineScaleSetVmByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if id.Parent == nil {
+		return nil, fmt.Errorf("invalid scale set instance id %s", resourceID)
+	}
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	v, err := clientFactory.NewVirtualMachineScaleSetVMsClient().Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, &armcompute.VirtualMachineScaleSetVMsClientGetOptions{
+		Expand: to.Ptr(armcompute.InstanceViewTypesInstanceView),
+	})
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	vm, err := clientFactory.NewVirtualMachineScaleSetsClient().Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeVirtualMachineScaleSetVm(ctx, &vm.VirtualMachineScaleSet, &v.VirtualMachineScaleSetVM), nil
+}
+
 func getComputeVirtualMachineScaleSetVm(ctx context.Context, vm *armcompute.VirtualMachineScaleSet, v *armcompute.VirtualMachineScaleSetVM) *models.Resource {
 	resourceGroupName := strings.Split(*v.ID, "/")[4]
 
@@ -302,60 +412,89 @@ func getStatusFromCode(statuses []*armcompute.InstanceViewStatus, codeType strin
 	return ""
 }
 
-func ComputeVirtualMachine(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+// listVirtualMachines lists the virtual machines of the subscription, once per job.
+func listVirtualMachines(ctx context.Context, cred azcore.TokenCredential, subscription string) ([]*armcompute.VirtualMachine, error) {
+	return cachedParents(ctx, "virtualMachines/"+subscription, func() ([]*armcompute.VirtualMachine, error) {
+		client, err := armcompute.NewVirtualMachinesClient(subscription, cred, clientOptions(ctx))
+		if err != nil {
+			return nil, err
+		}
+		pager := client.NewListAllPager(nil)
+		var values []*armcompute.VirtualMachine
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, page.Value...)
+		}
+		return values, nil
+	})
+}
+
+func ComputeVirtualMachine(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	virtualMachines, err := listVirtualMachines(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
+	return describeComputeVirtualMachines(ctx, cred, subscription, virtualMachines, stream)
+}
+
+// ComputeVirtualMachineByID describes the virtual machine with the ARM id.
+func ComputeVirtualMachineByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewVirtualMachinesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	vm, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return firstResource(describeComputeVirtualMachines(ctx, cred, subscription, []*armcompute.VirtualMachine{&vm.VirtualMachine}, nil))
+}
+
+func describeComputeVirtualMachines(ctx context.Context, cred azcore.TokenCredential, subscription string, virtualMachines []*armcompute.VirtualMachine, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	vmClient := clientFactory.NewVirtualMachinesClient()
 	vmExtensionsClient := clientFactory.NewVirtualMachineExtensionsClient()
 
-	networkInterfaceClient, err := armnetwork.NewInterfacesClient(subscription, cred, nil)
+	networkInterfaceClient, err := armnetwork.NewInterfacesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	networkPublicIPClient, err := armnetwork.NewPublicIPAddressesClient(subscription, cred, nil)
+	networkPublicIPClient, err := armnetwork.NewPublicIPAddressesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	ipConfigClient, err := armnetwork.NewInterfaceIPConfigurationsClient(subscription, cred, nil)
+	ipConfigClient, err := armnetwork.NewInterfaceIPConfigurationsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	guestConfigurationClientFactory, err := armguestconfiguration.NewClientFactory(subscription, cred, nil)
+	guestConfigurationClientFactory, err := armguestconfiguration.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	guestConfigurationClient := guestConfigurationClientFactory.NewAssignmentsClient()
 
-	pager := vmClient.NewListAllPager(nil)
-	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, virtualMachine := range page.Value {
-			resource, err := getComputeVirtualMachine(ctx, vmClient, vmExtensionsClient, networkInterfaceClient, networkPublicIPClient, ipConfigClient, guestConfigurationClient, virtualMachine)
-			if err != nil {
-				return nil, err
-			}
-			if stream != nil {
-				if err := (*stream)(*resource); err != nil {
-					return nil, err
-				}
-			} else {
-				values = append(values, *resource)
-			}
-		}
-	}
-	return values, nil
+	return describeItems(ctx, virtualMachines, stream, func(ctx context.Context, virtualMachine *armcompute.VirtualMachine) (*models.Resource, error) {
+		return getComputeVirtualMachine(ctx, vmClient, vmExtensionsClient, networkInterfaceClient, networkPublicIPClient, ipConfigClient, guestConfigurationClient, virtualMachine)
+	})
 }
 
 func getComputeVirtualMachine(ctx context.Context, vmClient *armcompute.VirtualMachinesClient, vmExtensionsClient *armcompute.VirtualMachineExtensionsClient, networkInterfaceClient *armnetwork.InterfacesClient, networkPublicIPClient *armnetwork.PublicIPAddressesClient, ipConfigClient *armnetwork.InterfaceIPConfigurationsClient, guestConfigurationClient *armguestconfiguration.AssignmentsClient, virtualMachine *armcompute.VirtualMachine) (*models.Resource, error) {
 	resourceGroupName := strings.Split(*virtualMachine.ID, "/")[4]
 	computeInstanceViewOp, err := vmClient.InstanceView(ctx, resourceGroupName, *virtualMachine.Name, nil)
+	if err != nil && !Tolerate(ctx, *virtualMachine.ID, "VirtualMachines.InstanceView", err) {
+		return nil, err
+	}
 
 	var ipConfigs = make([]armnetwork.InterfaceIPConfiguration, 0, 0)
 	if virtualMachine.Properties.VirtualMachineScaleSet != nil && virtualMachine.Properties.VirtualMachineScaleSet.ID != nil {
@@ -368,6 +507,9 @@ func getComputeVirtualMachine(ctx context.Context, vmClient *armcompute.VirtualM
 				if strings.Contains(err.Error(), "ERROR CODE: NotFound") {
 					continue
 				}
+				if Tolerate(ctx, *virtualMachine.ID, "NetworkInterfaces.ListVirtualMachineScaleSetNetworkInterfaces", err) {
+					break
+				}
 				return nil, err
 			}
 			for _, n := range page.Value {
@@ -375,6 +517,9 @@ func getComputeVirtualMachine(ctx context.Context, vmClient *armcompute.VirtualM
 				for ipPager.More() {
 					ipPage, err := ipPager.NextPage(ctx)
 					if err != nil {
+						if Tolerate(ctx, *virtualMachine.ID, "InterfaceIPConfigurations.List", err) {
+							break
+						}
 						return nil, err
 					}
 					for _, ip := range ipPage.Value {
@@ -395,6 +540,9 @@ func getComputeVirtualMachine(ctx context.Context, vmClient *armcompute.VirtualM
 			publicIP, err := networkPublicIPClient.Get(ctx, resourceGroup, name, nil)
 
 			if err != nil {
+				if Tolerate(ctx, *virtualMachine.ID, "PublicIPAddresses.Get", err) {
+					continue
+				}
 				return nil, err
 			}
 			if publicIP.Properties.IPAddress != nil {
@@ -404,7 +552,7 @@ func getComputeVirtualMachine(ctx context.Context, vmClient *armcompute.VirtualM
 	}
 
 	computeListOp, err := vmExtensionsClient.List(ctx, resourceGroupName, *virtualMachine.Name, nil)
-	if err != nil {
+	if err != nil && !Tolerate(ctx, *virtualMachine.ID, "VirtualMachineExtensions.List", err) {
 		return nil, err
 	}
 
@@ -416,6 +564,9 @@ func getComputeVirtualMachine(ctx context.Context, vmClient *armcompute.VirtualM
 			if strings.Contains(err.Error(), "NotFound") {
 				break
 			}
+			if Tolerate(ctx, *virtualMachine.ID, "GuestConfigurationAssignments.List", err) {
+				break
+			}
 			return nil, err
 		}
 		for _, v := range page.Value {
@@ -458,8 +609,8 @@ func getComputeVirtualMachine(ctx context.Context, vmClient *armcompute.VirtualM
 	return &resource, nil
 }
 
-func ComputeSnapshots(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeSnapshots(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -486,6 +637,23 @@ func ComputeSnapshots(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// ComputeSnapshotByID describes the snapshot with the ARM id.
+func ComputeSnapshotByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewSnapshotsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeSnapshot(ctx, &resp.Snapshot), nil
+}
+
 func getComputeSnapshot(ctx context.Context, snapshot *armcompute.Snapshot) *models.Resource {
 	resourceGroupName := strings.Split(*snapshot.ID, "/")[4]
 
@@ -503,8 +671,8 @@ func getComputeSnapshot(ctx context.Context, snapshot *armcompute.Snapshot) *mod
 	return &resource
 }
 
-func ComputeAvailabilitySet(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeAvailabilitySet(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -531,6 +699,23 @@ func ComputeAvailabilitySet(ctx context.Context, cred *azidentity.ClientSecretCr
 	return values, nil
 }
 
+// ComputeAvailabilitySetByID describes the availability set with the ARM id.
+func ComputeAvailabilitySetByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewAvailabilitySetsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeAvailabilitySet(ctx, &resp.AvailabilitySet), nil
+}
+
 func getComputeAvailabilitySet(ctx context.Context, availabilitySet *armcompute.AvailabilitySet) *models.Resource {
 	resourceGroupName := strings.Split(*availabilitySet.ID, "/")[4]
 
@@ -548,8 +733,8 @@ func getComputeAvailabilitySet(ctx context.Context, availabilitySet *armcompute.
 	return &resource
 }
 
-func ComputeDiskEncryptionSet(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeDiskEncryptionSet(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -576,6 +761,23 @@ func ComputeDiskEncryptionSet(ctx context.Context, cred *azidentity.ClientSecret
 	return values, nil
 }
 
+// ComputeDiskEncryptionSetByID describes the disk encryption set with the ARM id.
+func ComputeDiskEncryptionSetByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewDiskEncryptionSetsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeDiskEncryptionSet(ctx, &resp.DiskEncryptionSet), nil
+}
+
 func getComputeDiskEncryptionSet(ctx context.Context, diskEncryptionSet *armcompute.DiskEncryptionSet) *models.Resource {
 	resourceGroupName := strings.Split(*diskEncryptionSet.ID, "/")[4]
 
@@ -593,8 +795,8 @@ func getComputeDiskEncryptionSet(ctx context.Context, diskEncryptionSet *armcomp
 	return &resource
 }
 
-func ComputeGallery(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeGallery(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -621,6 +823,23 @@ func ComputeGallery(ctx context.Context, cred *azidentity.ClientSecretCredential
 	return values, nil
 }
 
+// ComputeGalleryByID describes the gallery with the ARM id.
+func ComputeGalleryByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewGalleriesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeGallery(ctx, &resp.Gallery), nil
+}
+
 func getComputeGallery(ctx context.Context, gallery *armcompute.Gallery) *models.Resource {
 	resourceGroupName := strings.Split(*gallery.ID, "/")[4]
 
@@ -638,8 +857,8 @@ func getComputeGallery(ctx context.Context, gallery *armcompute.Gallery) *models
 	return &resource
 }
 
-func ComputeImage(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeImage(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -666,6 +885,23 @@ func ComputeImage(ctx context.Context, cred *azidentity.ClientSecretCredential,
 	return values, nil
 }
 
+// ComputeImageByID describes the image with the ARM id.
+func ComputeImageByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewImagesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeImage(ctx, &resp.Image), nil
+}
+
 func getComputeImage(ctx context.Context, v *armcompute.Image) *models.Resource {
 	resourceGroup := strings.ToLower(strings.Split(*v.ID, "/")[4])
 	resource := models.Resource{
@@ -682,8 +918,8 @@ func getComputeImage(ctx context.Context, v *armcompute.Image) *models.Resource
 	return &resource
 }
 
-func ComputeHostGroup(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeHostGroup(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -710,6 +946,23 @@ func ComputeHostGroup(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// ComputeHostGroupByID describes the dedicated host group with the ARM id.
+func ComputeHostGroupByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewDedicatedHostGroupsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeHostGroup(ctx, &resp.DedicatedHostGroup), nil
+}
+
 func getComputeHostGroup(ctx context.Context, v *armcompute.DedicatedHostGroup) *models.Resource {
 	resourceGroup := strings.ToLower(strings.Split(*v.ID, "/")[4])
 	resource := models.Resource{
@@ -726,8 +979,8 @@ func getComputeHostGroup(ctx context.Context, v *armcompute.DedicatedHostGroup)
 	return &resource
 }
 
-func ComputeHost(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeHost(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -760,6 +1013,26 @@ func ComputeHost(ctx context.Context, cred *azidentity.ClientSecretCredential, s
 	return values, nil
 }
 
+// ComputeHostByID describes the dedicated host with the ARM id.
+func ComputeHostByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if id.Parent == nil {
+		return nil, fmt.Errorf("invalid dedicated host id %s", resourceID)
+	}
+	client, err := armcompute.NewDedicatedHostsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeHost(ctx, &resp.DedicatedHost), nil
+}
+
 func getComputeHostsByGroup(ctx context.Context, hostClient *armcompute.DedicatedHostsClient, v *armcompute.DedicatedHostGroup) ([]models.Resource, error) {
 	resourceGroup := strings.ToLower(strings.Split(*v.ID, "/")[4])
 
@@ -771,25 +1044,30 @@ func getComputeHostsByGroup(ctx context.Context, hostClient *armcompute.Dedicate
 			return nil, err
 		}
 		for _, host := range page.Value {
-			resource := models.Resource{
-				ID:       *v.ID,
-				Name:     *v.Name,
-				Location: *v.Location,
-				Description: JSONAllFieldsMarshaller{
-					Value: model.ComputeHostGroupHostDescription{
-						Host:          *host,
-						ResourceGroup: resourceGroup,
-					},
-				},
-			}
-			resources = append(resources, resource)
+			resources = append(resources, *getComputeHost(ctx, host))
 		}
 	}
 	return resources, nil
 }
 
-func ComputeRestorePointCollection(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func getComputeHost(ctx context.Context, host *armcompute.DedicatedHost) *models.Resource {
+	resourceGroup := strings.ToLower(strings.Split(*host.ID, "/")[4])
+	resource := models.Resource{
+		ID:       *host.ID,
+		Name:     *host.Name,
+		Location: *host.Location,
+		Description: JSONAllFieldsMarshaller{
+			Value: model.ComputeHostGroupHostDescription{
+				Host:          *host,
+				ResourceGroup: resourceGroup,
+			},
+		},
+	}
+	return &resource
+}
+
+func ComputeRestorePointCollection(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -816,6 +1094,23 @@ func ComputeRestorePointCollection(ctx context.Context, cred *azidentity.ClientS
 	return values, nil
 }
 
+// ComputeRestorePointCollectionByID describes the restore point collection with the ARM id.
+func ComputeRestorePointCollectionByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewRestorePointCollectionsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeResourcePointCollection(ctx, &resp.RestorePointCollection), nil
+}
+
 func getComputeResourcePointCollection(ctx context.Context, v *armcompute.RestorePointCollection) *models.Resource {
 	resourceGroup := strings.ToLower(strings.Split(*v.ID, "/")[4])
 	resource := models.Resource{
@@ -832,8 +1127,8 @@ func getComputeResourcePointCollection(ctx context.Context, v *armcompute.Restor
 	return &resource
 }
 
-func ComputeSSHPublicKey(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeSSHPublicKey(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -860,6 +1155,23 @@ func ComputeSSHPublicKey(ctx context.Context, cred *azidentity.ClientSecretCrede
 	return values, nil
 }
 
+// ComputeSSHPublicKeyByID describes the SSH public key with the ARM id.
+func ComputeSSHPublicKeyByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewSSHPublicKeysClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeSSHPublicKey(ctx, &resp.SSHPublicKeyResource), nil
+}
+
 func getComputeSSHPublicKey(ctx context.Context, v *armcompute.SSHPublicKeyResource) *models.Resource {
 	resourceGroup := strings.ToLower(strings.Split(*v.ID, "/")[4])
 	resource := models.Resource{
@@ -876,8 +1188,8 @@ func getComputeSSHPublicKey(ctx context.Context, v *armcompute.SSHPublicKeyResou
 	return &resource
 }
 
-func ComputeDiskReadOps(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeDiskReadOps(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -916,7 +1228,7 @@ func ComputeDiskReadOps(ctx context.Context, cred *azidentity.ClientSecretCreden
 	return values, nil
 }
 
-func getComputeDiskReadOps(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
+func getComputeDiskReadOps(ctx context.Context, cred azcore.TokenCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
 	metrics, err := listAzureMonitorMetricStatistics(ctx, cred, subscription, "FIVE_MINUTES", "Microsoft.Compute/disks", "Composite Disk Read Operations/sec", *disk.ID)
 	if err != nil {
 		return nil, err
@@ -938,8 +1250,8 @@ func getComputeDiskReadOps(ctx context.Context, cred *azidentity.ClientSecretCre
 	return values, nil
 }
 
-func ComputeDiskReadOpsDaily(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeDiskReadOpsDaily(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -978,7 +1290,7 @@ func ComputeDiskReadOpsDaily(ctx context.Context, cred *azidentity.ClientSecretC
 	return values, nil
 }
 
-func getComputeDiskReadOpsDaily(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
+func getComputeDiskReadOpsDaily(ctx context.Context, cred azcore.TokenCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
 	metrics, err := listAzureMonitorMetricStatistics(ctx, cred, subscription, "DAILY", "Microsoft.Compute/disks", "Composite Disk Read Operations/sec", *disk.ID)
 	if err != nil {
 		return nil, err
@@ -999,8 +1311,8 @@ func getComputeDiskReadOpsDaily(ctx context.Context, cred *azidentity.ClientSecr
 	}
 	return values, nil
 }
-func ComputeDiskReadOpsHourly(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeDiskReadOpsHourly(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1039,7 +1351,7 @@ func ComputeDiskReadOpsHourly(ctx context.Context, cred *azidentity.ClientSecret
 	return values, nil
 }
 
-func getComputeDiskReadOpsHourly(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
+func getComputeDiskReadOpsHourly(ctx context.Context, cred azcore.TokenCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
 	metrics, err := listAzureMonitorMetricStatistics(ctx, cred, subscription, "HOURLY", "Microsoft.Compute/disks", "Composite Disk Read Operations/sec", *disk.ID)
 	if err != nil {
 		return nil, err
@@ -1061,8 +1373,8 @@ func getComputeDiskReadOpsHourly(ctx context.Context, cred *azidentity.ClientSec
 	return values, nil
 }
 
-func ComputeDiskWriteOps(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeDiskWriteOps(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1101,7 +1413,7 @@ func ComputeDiskWriteOps(ctx context.Context, cred *azidentity.ClientSecretCrede
 	return values, nil
 }
 
-func getComputeDiskWriteOps(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
+func getComputeDiskWriteOps(ctx context.Context, cred azcore.TokenCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
 	metrics, err := listAzureMonitorMetricStatistics(ctx, cred, subscription, "FIVE_MINUTES", "Microsoft.Compute/disks", "Composite Disk Write Operations/sec", *disk.ID)
 	if err != nil {
 		return nil, err
@@ -1123,8 +1435,8 @@ func getComputeDiskWriteOps(ctx context.Context, cred *azidentity.ClientSecretCr
 	return values, nil
 }
 
-func ComputeDiskWriteOpsDaily(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeDiskWriteOpsDaily(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1163,7 +1475,7 @@ func ComputeDiskWriteOpsDaily(ctx context.Context, cred *azidentity.ClientSecret
 	return values, nil
 }
 
-func getComputeDiskWriteOpsDaily(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
+func getComputeDiskWriteOpsDaily(ctx context.Context, cred azcore.TokenCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
 	metrics, err := listAzureMonitorMetricStatistics(ctx, cred, subscription, "DAILY", "Microsoft.Compute/disks", "Composite Disk Write Operations/sec", *disk.ID)
 	if err != nil {
 		return nil, err
@@ -1184,8 +1496,8 @@ func getComputeDiskWriteOpsDaily(ctx context.Context, cred *azidentity.ClientSec
 	}
 	return values, nil
 }
-func ComputeDiskWriteOpsHourly(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeDiskWriteOpsHourly(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1224,7 +1536,7 @@ func ComputeDiskWriteOpsHourly(ctx context.Context, cred *azidentity.ClientSecre
 	return values, nil
 }
 
-func getComputeDiskWriteOpsHourly(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
+func getComputeDiskWriteOpsHourly(ctx context.Context, cred azcore.TokenCredential, subscription string, disk *armcompute.Disk) ([]models.Resource, error) {
 	metrics, err := listAzureMonitorMetricStatistics(ctx, cred, subscription, "HOURLY", "Microsoft.Compute/disks", "Composite Disk Write Operations/sec", *disk.ID)
 	if err != nil {
 		return nil, err
@@ -1246,8 +1558,8 @@ func getComputeDiskWriteOpsHourly(ctx context.Context, cred *azidentity.ClientSe
 	return values, nil
 }
 
-func ComputeResourceSKU(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeResourceSKU(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1294,47 +1606,35 @@ func getComputeResourceSKU(ctx context.Context, subscription string, resourceSku
 	return &resource
 }
 
-func ComputeVirtualMachineCpuUtilization(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
-	if err != nil {
-		return nil, err
-	}
-	client := clientFactory.NewVirtualMachinesClient()
-
-	pager := client.NewListAllPager(nil)
+func ComputeVirtualMachineCpuUtilization(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	virtualMachines, err := listVirtualMachines(ctx, cred, subscription)
 	if err != nil {
 		return nil, err
 	}
 
 	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
+	for _, virtualMachine := range virtualMachines {
+		if virtualMachine.ID == nil {
+			continue
+		}
+		resources, err := getComputeVirtualMachineCpuUtilization(ctx, cred, subscription, virtualMachine)
 		if err != nil {
 			return nil, err
 		}
-		for _, virtualMachine := range page.Value {
-			if virtualMachine.ID == nil {
-				continue
-			}
-			resources, err := getComputeVirtualMachineCpuUtilization(ctx, cred, subscription, virtualMachine)
-			if err != nil {
-				return nil, err
-			}
-			for _, resource := range resources {
-				if stream != nil {
-					if err := (*stream)(resource); err != nil {
-						return nil, err
-					}
-				} else {
-					values = append(values, resource)
+		for _, resource := range resources {
+			if stream != nil {
+				if err := (*stream)(resource); err != nil {
+					return nil, err
 				}
+			} else {
+				values = append(values, resource)
 			}
 		}
 	}
 	return values, nil
 }
 
-func getComputeVirtualMachineCpuUtilization(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, virtualMachine *armcompute.VirtualMachine) ([]models.Resource, error) {
+func getComputeVirtualMachineCpuUtilization(ctx context.Context, cred azcore.TokenCredential, subscription string, virtualMachine *armcompute.VirtualMachine) ([]models.Resource, error) {
 	metrics, err := listAzureMonitorMetricStatistics(ctx, cred, subscription, "FIVE_MINUTES", "Microsoft.Compute/virtualMachines", "Percentage CPU", *virtualMachine.ID)
 	if err != nil {
 		return nil, err
@@ -1356,47 +1656,35 @@ func getComputeVirtualMachineCpuUtilization(ctx context.Context, cred *azidentit
 	return values, nil
 }
 
-func ComputeVirtualMachineCpuUtilizationDaily(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
-	if err != nil {
-		return nil, err
-	}
-	client := clientFactory.NewVirtualMachinesClient()
-
-	pager := client.NewListAllPager(nil)
+func ComputeVirtualMachineCpuUtilizationDaily(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	virtualMachines, err := listVirtualMachines(ctx, cred, subscription)
 	if err != nil {
 		return nil, err
 	}
 
 	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
+	for _, virtualMachine := range virtualMachines {
+		if virtualMachine.ID == nil {
+			continue
+		}
+		resources, err := getComputeVirtualMachineCpuUtilizationDaily(ctx, cred, subscription, virtualMachine)
 		if err != nil {
 			return nil, err
 		}
-		for _, virtualMachine := range page.Value {
-			if virtualMachine.ID == nil {
-				continue
-			}
-			resources, err := getComputeVirtualMachineCpuUtilizationDaily(ctx, cred, subscription, virtualMachine)
-			if err != nil {
-				return nil, err
-			}
-			for _, resource := range resources {
-				if stream != nil {
-					if err := (*stream)(resource); err != nil {
-						return nil, err
-					}
-				} else {
-					values = append(values, resource)
+		for _, resource := range resources {
+			if stream != nil {
+				if err := (*stream)(resource); err != nil {
+					return nil, err
 				}
+			} else {
+				values = append(values, resource)
 			}
 		}
 	}
 	return values, nil
 }
 
-func getComputeVirtualMachineCpuUtilizationDaily(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, virtualMachine *armcompute.VirtualMachine) ([]models.Resource, error) {
+func getComputeVirtualMachineCpuUtilizationDaily(ctx context.Context, cred azcore.TokenCredential, subscription string, virtualMachine *armcompute.VirtualMachine) ([]models.Resource, error) {
 	metrics, err := listAzureMonitorMetricStatistics(ctx, cred, subscription, "DAILY", "Microsoft.Compute/virtualMachines", "Percentage CPU", *virtualMachine.ID)
 	if err != nil {
 		return nil, err
@@ -1418,47 +1706,35 @@ func getComputeVirtualMachineCpuUtilizationDaily(ctx context.Context, cred *azid
 	return values, nil
 }
 
-func ComputeVirtualMachineCpuUtilizationHourly(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
-	if err != nil {
-		return nil, err
-	}
-	client := clientFactory.NewVirtualMachinesClient()
-
-	pager := client.NewListAllPager(nil)
+func ComputeVirtualMachineCpuUtilizationHourly(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	virtualMachines, err := listVirtualMachines(ctx, cred, subscription)
 	if err != nil {
 		return nil, err
 	}
 
 	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
+	for _, virtualMachine := range virtualMachines {
+		if virtualMachine.ID == nil {
+			continue
+		}
+		resources, err := getComputeVirtualMachineCpuUtilizationHourly(ctx, cred, subscription, virtualMachine)
 		if err != nil {
 			return nil, err
 		}
-		for _, virtualMachine := range page.Value {
-			if virtualMachine.ID == nil {
-				continue
-			}
-			resources, err := getComputeVirtualMachineCpuUtilizationHourly(ctx, cred, subscription, virtualMachine)
-			if err != nil {
-				return nil, err
-			}
-			for _, resource := range resources {
-				if stream != nil {
-					if err := (*stream)(resource); err != nil {
-						return nil, err
-					}
-				} else {
-					values = append(values, resource)
+		for _, resource := range resources {
+			if stream != nil {
+				if err := (*stream)(resource); err != nil {
+					return nil, err
 				}
+			} else {
+				values = append(values, resource)
 			}
 		}
 	}
 	return values, nil
 }
 
-func getComputeVirtualMachineCpuUtilizationHourly(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, virtualMachine *armcompute.VirtualMachine) ([]models.Resource, error) {
+func getComputeVirtualMachineCpuUtilizationHourly(ctx context.Context, cred azcore.TokenCredential, subscription string, virtualMachine *armcompute.VirtualMachine) ([]models.Resource, error) {
 	metrics, err := listAzureMonitorMetricStatistics(ctx, cred, subscription, "HOURLY", "Microsoft.Compute/virtualMachines", "Percentage CPU", *virtualMachine.ID)
 	if err != nil {
 		return nil, err
@@ -1480,8 +1756,8 @@ func getComputeVirtualMachineCpuUtilizationHourly(ctx context.Context, cred *azi
 	return values, nil
 }
 
-func ComputeCloudServices(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcompute.NewClientFactory(subscription, cred, nil)
+func ComputeCloudServices(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcompute.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1508,6 +1784,23 @@ func ComputeCloudServices(ctx context.Context, cred *azidentity.ClientSecretCred
 	return values, nil
 }
 
+// ComputeCloudServiceByID describes the cloud service with the ARM id.
+func ComputeCloudServiceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewCloudServicesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getComputeCloudServices(ctx, &resp.CloudService), nil
+}
+
 func getComputeCloudServices(ctx context.Context, v *armcompute.CloudService) *models.Resource {
 	resource := models.Resource{
 		ID:       *v.ID,
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerinstance/armcontainerinstance"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func ContainerInstanceContainerGroups(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armcontainerinstance.NewContainerGroupsClient(subscription, cred, nil)
+func ContainerInstanceContainerGroups(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armcontainerinstance.NewContainerGroupsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -54,3 +55,20 @@ func getContainerInstanceContainerGrou(ctx context.Context, v *armcontainerinsta
 
 	return &resource
 }
+
+// ContainerInstanceContainerGroupByID describes the container group with the ARM id.
+func ContainerInstanceContainerGroupByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcontainerinstance.NewContainerGroupsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getContainerInstanceContainerGrou(ctx, &resp.ContainerGroup), nil
+}
@@ -5,14 +5,15 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerregistry/armcontainerregistry"
 
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func ContainerRegistry(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcontainerregistry.NewClientFactory(subscription, cred, nil)
+func ContainerRegistry(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcontainerregistry.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -84,3 +85,21 @@ func getContainerRegistry(ctx context.Context, client *armcontainerregistry.Regi
 	}
 	return &resource, nil
 }
+
+// ContainerRegistryByID describes the container registry with the ARM id.
+func ContainerRegistryByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armcontainerregistry.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	client := clientFactory.NewRegistriesClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getContainerRegistry(ctx, client, clientFactory.NewWebhooksClient(), &resp.Registry)
+}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armsubscriptions"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,8 +12,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func KubernetesCluster(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armcontainerservice.NewManagedClustersClient(subscription, cred, nil)
+func KubernetesCluster(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armcontainerservice.NewManagedClustersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -55,13 +56,30 @@ func getKubernatesCluster(ctx context.Context, v *armcontainerservice.ManagedClu
 	return &resource
 }
 
-func KubernetesServiceVersion(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	subClient, err := armsubscriptions.NewClient(cred, nil)
+// KubernetesClusterByID describes the Kubernetes cluster with the ARM id.
+func KubernetesClusterByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcontainerservice.NewManagedClustersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getKubernatesCluster(ctx, &resp.ManagedCluster), nil
+}
+
+func KubernetesServiceVersion(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	subClient, err := armsubscriptions.NewClient(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := armcontainerservice.NewManagedClustersClient(subscription, cred, nil)
+	client, err := armcontainerservice.NewManagedClustersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 
 var (
 	triggerTypeKey string = "trigger_type"
+	tenantIDKey    string = "tenant_id"
 )
 
 func WithTriggerType(ctx context.Context, tt enums.DescribeTriggerType) context.Context {
@@ -37,3 +38,12 @@ func GetLoggerFromContext(ctx context.Context) *zap.Logger {
 	}
 	return logger
 }
+
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey, tenantID)
+}
+
+func GetTenantIDFromContext(ctx context.Context) string {
+	tenantID, _ := ctx.Value(tenantIDKey).(string)
+	return tenantID
+}
@@ -8,7 +8,7 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/costmanagement/armcostmanagement"
 
 	"github.com/opengovern/og-util/pkg/describe/enums"
@@ -20,9 +20,9 @@ const serviceNameDimension = "ServiceName"
 const publisherTypeDimension = "PublisherType"
 const subscriptionDimension = "SubscriptionId"
 
-func cost(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, from time.Time, to time.Time, dimension string) ([]model.CostManagementQueryRow, *string, error) {
+func cost(ctx context.Context, cred azcore.TokenCredential, subscription string, from time.Time, to time.Time, dimension string) ([]model.CostManagementQueryRow, *string, error) {
 	var err error
-	clientFactory, err := armcostmanagement.NewClientFactory(cred, nil)
+	clientFactory, err := armcostmanagement.NewClientFactory(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -96,7 +96,7 @@ func cost(ctx context.Context, cred *azidentity.ClientSecretCredential, subscrip
 	return result, costs.Location, nil
 }
 
-func DailyCostByResourceType(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func DailyCostByResourceType(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 	triggerType := GetTriggerTypeFromContext(ctx)
 	from := time.Now().AddDate(0, 0, -7)
 	if time.Now().Day() == 6 {
@@ -173,7 +173,7 @@ func DailyCostByResourceType(ctx context.Context, cred *azidentity.ClientSecretC
 	return values, nil
 }
 
-func DailyCostBySubscription(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func DailyCostBySubscription(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 	triggerType := GetTriggerTypeFromContext(ctx)
 	from := time.Now().AddDate(0, 0, -7)
 	if triggerType == enums.DescribeTriggerTypeInitialDiscovery {
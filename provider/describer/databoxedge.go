@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/databoxedge/armdataboxedge"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func DataboxEdgeDevice(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armdataboxedge.NewClientFactory(subscription, cred, nil)
+func DataboxEdgeDevice(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armdataboxedge.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -53,3 +54,20 @@ func getDataboxEdgeDevice(ctx context.Context, v *armdataboxedge.Device) *models
 	}
 	return &resource
 }
+
+// DataboxEdgeDeviceByID describes the Data Box Edge device with the ARM id.
+func DataboxEdgeDeviceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdataboxedge.NewDevicesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.Name, id.ResourceGroupName, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDataboxEdgeDevice(ctx, &resp.Device), nil
+}
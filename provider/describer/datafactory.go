@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/datafactory/armdatafactory/v2"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,12 +11,12 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func DataFactory(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armdatafactory.NewFactoriesClient(subscription, cred, nil)
+func DataFactory(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armdatafactory.NewFactoriesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	connClient, err := armdatafactory.NewPrivateEndPointConnectionsClient(subscription, cred, nil)
+	connClient, err := armdatafactory.NewPrivateEndPointConnectionsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +74,33 @@ func getDataFactory(ctx context.Context, connClient *armdatafactory.PrivateEndPo
 	return &resource, nil
 }
 
-func DataFactoryDataset(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armdatafactory.NewFactoriesClient(subscription, cred, nil)
+// DataFactoryByID describes the data factory with the ARM id.
+func DataFactoryByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
 	if err != nil {
 		return nil, err
 	}
-	datasetsClient, err := armdatafactory.NewDatasetsClient(subscription, cred, nil)
+	client, err := armdatafactory.NewFactoriesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	connClient, err := armdatafactory.NewPrivateEndPointConnectionsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDataFactory(ctx, connClient, &resp.Factory)
+}
+
+func DataFactoryDataset(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armdatafactory.NewFactoriesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	datasetsClient, err := armdatafactory.NewDatasetsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -122,31 +144,61 @@ func getDataFactoryDataset(ctx context.Context, client *armdatafactory.DatasetsC
 			return nil, err
 		}
 		for _, dataset := range page.Value {
-			resource := models.Resource{
-				ID:       *dataset.ID,
-				Name:     *dataset.Name,
-				Location: *factory.Location,
-				Description: JSONAllFieldsMarshaller{
-					Value: model.DataFactoryDatasetDescription{
-						Factory:       *factory,
-						Dataset:       *dataset,
-						ResourceGroup: factoryResourceGroup,
-					},
-				},
-			}
-			values = append(values, resource)
+			values = append(values, *getDataFactoryDatasetResource(ctx, factory, dataset))
 		}
 	}
 
 	return values, nil
 }
 
-func DataFactoryPipeline(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armdatafactory.NewFactoriesClient(subscription, cred, nil)
+func getDataFactoryDatasetResource(ctx context.Context, factory *armdatafactory.Factory, dataset *armdatafactory.DatasetResource) *models.Resource {
+	factoryResourceGroup := strings.Split(*factory.ID, "/")[4]
+
+	return &models.Resource{
+		ID:       *dataset.ID,
+		Name:     *dataset.Name,
+		Location: *factory.Location,
+		Description: JSONAllFieldsMarshaller{
+			Value: model.DataFactoryDatasetDescription{
+				Factory:       *factory,
+				Dataset:       *dataset,
+				ResourceGroup: factoryResourceGroup,
+			},
+		},
+	}
+}
+
+// DataFactoryDatasetByID describes the data factory dataset with the ARM id.
+func DataFactoryDatasetByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdatafactory.NewDatasetsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	pipelineClient, err := armdatafactory.NewPipelinesClient(subscription, cred, nil)
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armdatafactory.NewFactoriesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	factory, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDataFactoryDatasetResource(ctx, &factory.Factory, &resp.DatasetResource), nil
+}
+
+func DataFactoryPipeline(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armdatafactory.NewFactoriesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	pipelineClient, err := armdatafactory.NewPipelinesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -190,21 +242,51 @@ func getDataFactoryPipeline(ctx context.Context, client *armdatafactory.Pipeline
 			return nil, err
 		}
 		for _, pipeline := range page.Value {
-			resource := models.Resource{
-				ID:       *pipeline.ID,
-				Name:     *pipeline.Name,
-				Location: *factory.Location,
-				Description: JSONAllFieldsMarshaller{
-					Value: model.DataFactoryPipelineDescription{
-						Factory:       *factory,
-						Pipeline:      *pipeline,
-						ResourceGroup: factoryResourceGroup,
-					},
-				},
-			}
-			values = append(values, resource)
+			values = append(values, *getDataFactoryPipelineResource(ctx, factory, pipeline))
 		}
 	}
 
 	return values, nil
 }
+
+func getDataFactoryPipelineResource(ctx context.Context, factory *armdatafactory.Factory, pipeline *armdatafactory.PipelineResource) *models.Resource {
+	factoryResourceGroup := strings.Split(*factory.ID, "/")[4]
+
+	return &models.Resource{
+		ID:       *pipeline.ID,
+		Name:     *pipeline.Name,
+		Location: *factory.Location,
+		Description: JSONAllFieldsMarshaller{
+			Value: model.DataFactoryPipelineDescription{
+				Factory:       *factory,
+				Pipeline:      *pipeline,
+				ResourceGroup: factoryResourceGroup,
+			},
+		},
+	}
+}
+
+// DataFactoryPipelineByID describes the data factory pipeline with the ARM id.
+func DataFactoryPipelineByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdatafactory.NewPipelinesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armdatafactory.NewFactoriesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	factory, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDataFactoryPipelineResource(ctx, &factory.Factory, &resp.PipelineResource), nil
+}
@@ -5,14 +5,15 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dataprotection/armdataprotection"
 
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func DataProtectionBackupVaults(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armdataprotection.NewBackupVaultsClient(subscription, cred, nil)
+func DataProtectionBackupVaults(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armdataprotection.NewBackupVaultsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -54,12 +55,29 @@ func getDataProtectionBackupVaults(ctx context.Context, v *armdataprotection.Bac
 	return &resource
 }
 
-func DataProtectionBackupVaultsBackupPolicies(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armdataprotection.NewBackupVaultsClient(subscription, cred, nil)
+// DataProtectionBackupVaultByID describes the backup vault with the ARM id.
+func DataProtectionBackupVaultByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
 	if err != nil {
 		return nil, err
 	}
-	policiesClient, err := armdataprotection.NewBackupPoliciesClient(subscription, cred, nil)
+	client, err := armdataprotection.NewBackupVaultsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDataProtectionBackupVaults(ctx, &resp.BackupVaultResource), nil
+}
+
+func DataProtectionBackupVaultsBackupPolicies(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armdataprotection.NewBackupVaultsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	policiesClient, err := armdataprotection.NewBackupPoliciesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -100,33 +118,61 @@ func getDataProtectionBackupVaultsBackupPolicies(ctx context.Context, client *ar
 			return nil, err
 		}
 		for _, p := range page.Value {
-			resourceGroup := strings.Split(*v.ID, "/")[4]
-
-			resource := models.Resource{
-				ID:       *p.ID,
-				Name:     *p.Name,
-				Location: *v.Location,
-				Description: JSONAllFieldsMarshaller{
-					Value: model.DataProtectionBackupVaultsBackupPoliciesDescription{
-						BackupPolicies: *p,
-						ResourceGroup:  resourceGroup,
-					},
-				},
-			}
-			values = append(values, resource)
+			values = append(values, *getDataProtectionBackupVaultsBackupPolicy(ctx, v, p))
 		}
 	}
 	return values, nil
 }
 
-func DataProtectionBackupJobs(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func getDataProtectionBackupVaultsBackupPolicy(ctx context.Context, v *armdataprotection.BackupVaultResource, p *armdataprotection.BaseBackupPolicyResource) *models.Resource {
+	resourceGroup := strings.Split(*v.ID, "/")[4]
+
+	return &models.Resource{
+		ID:       *p.ID,
+		Name:     *p.Name,
+		Location: *v.Location,
+		Description: JSONAllFieldsMarshaller{
+			Value: model.DataProtectionBackupVaultsBackupPoliciesDescription{
+				BackupPolicies: *p,
+				ResourceGroup:  resourceGroup,
+			},
+		},
+	}
+}
+
+// DataProtectionBackupVaultsBackupPolicyByID describes the backup policy with the ARM id.
+func DataProtectionBackupVaultsBackupPolicyByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdataprotection.NewBackupPoliciesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armdataprotection.NewBackupVaultsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	vault, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDataProtectionBackupVaultsBackupPolicy(ctx, &vault.BackupVaultResource, &resp.BaseBackupPolicyResource), nil
+}
+
+func DataProtectionBackupJobs(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 
-	client, err := armdataprotection.NewBackupVaultsClient(subscription, cred, nil)
+	client, err := armdataprotection.NewBackupVaultsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	jobsClient, err := armdataprotection.NewJobsClient(subscription, cred, nil)
+	jobsClient, err := armdataprotection.NewJobsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -194,3 +240,28 @@ func GetDataPotectionJob(ctx context.Context, vault *armdataprotection.BackupVau
 	}
 	return &resource
 }
+
+// DataProtectionBackupJobByID describes the backup job with the ARM id.
+func DataProtectionBackupJobByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdataprotection.NewJobsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armdataprotection.NewBackupVaultsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	vault, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetDataPotectionJob(ctx, &vault.BackupVaultResource, &resp.AzureBackupJobResource), nil
+}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/desktopvirtualization/armdesktopvirtualization"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func DesktopVirtualizationWorkspaces(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armdesktopvirtualization.NewWorkspacesClient(subscription, cred, nil)
+func DesktopVirtualizationWorkspaces(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armdesktopvirtualization.NewWorkspacesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -23,18 +24,7 @@ func DesktopVirtualizationWorkspaces(ctx context.Context, cred *azidentity.Clien
 			return nil, err
 		}
 		for _, v := range page.Value {
-			resourceGroupName := strings.Split(string(*v.ID), "/")[4]
-			resource := &models.Resource{
-				ID:       *v.ID,
-				Name:     *v.Name,
-				Location: *v.Location,
-				Description: JSONAllFieldsMarshaller{
-					Value: model.DesktopVirtualizationWorkspaceDescription{
-						Workspace:     *v,
-						ResourceGroup: resourceGroupName,
-					},
-				},
-			}
+			resource := getDesktopVirtualizationWorkspace(ctx, v)
 			if stream != nil {
 				if err := (*stream)(*resource); err != nil {
 					return nil, err
@@ -47,8 +37,41 @@ func DesktopVirtualizationWorkspaces(ctx context.Context, cred *azidentity.Clien
 	return values, nil
 }
 
-func DesktopVirtualizationHostPool(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armdesktopvirtualization.NewHostPoolsClient(subscription, cred, nil)
+func getDesktopVirtualizationWorkspace(ctx context.Context, v *armdesktopvirtualization.Workspace) *models.Resource {
+	resourceGroupName := strings.Split(string(*v.ID), "/")[4]
+	resource := models.Resource{
+		ID:       *v.ID,
+		Name:     *v.Name,
+		Location: *v.Location,
+		Description: JSONAllFieldsMarshaller{
+			Value: model.DesktopVirtualizationWorkspaceDescription{
+				Workspace:     *v,
+				ResourceGroup: resourceGroupName,
+			},
+		},
+	}
+	return &resource
+}
+
+// DesktopVirtualizationWorkspaceByID describes the desktop virtualization workspace with the ARM id.
+func DesktopVirtualizationWorkspaceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdesktopvirtualization.NewWorkspacesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDesktopVirtualizationWorkspace(ctx, &resp.Workspace), nil
+}
+
+func DesktopVirtualizationHostPool(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armdesktopvirtualization.NewHostPoolsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -88,3 +111,20 @@ func getDesktopVirtualizationHostPool(ctx context.Context, v *armdesktopvirtuali
 	}
 	return &resource
 }
+
+// DesktopVirtualizationHostPoolByID describes the host pool with the ARM id.
+func DesktopVirtualizationHostPoolByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdesktopvirtualization.NewHostPoolsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDesktopVirtualizationHostPool(ctx, &resp.HostPool), nil
+}
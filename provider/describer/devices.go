@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/deviceprovisioningservices/armdeviceprovisioningservices"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/iothub/armiothub"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
@@ -13,8 +14,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func DevicesProvisioningServicesCertificates(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armdeviceprovisioningservices.NewDpsCertificateClient(subscription, cred, nil)
+func DevicesProvisioningServicesCertificates(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armdeviceprovisioningservices.NewDpsCertificateClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -71,8 +72,8 @@ func getDevicesProvisioningServicesCertificates(ctx context.Context, client *arm
 	return values, nil
 }
 
-func devicesProvisioningServices(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, resourceGroup string) ([]armdeviceprovisioningservices.ProvisioningServiceDescription, error) {
-	clientFactory, err := armdeviceprovisioningservices.NewClientFactory(subscription, cred, nil)
+func devicesProvisioningServices(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceGroup string) ([]armdeviceprovisioningservices.ProvisioningServiceDescription, error) {
+	clientFactory, err := armdeviceprovisioningservices.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -93,14 +94,14 @@ func devicesProvisioningServices(ctx context.Context, cred *azidentity.ClientSec
 	return values, nil
 }
 
-func IOTHub(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+func IOTHub(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
 
-	iotHubClient, err := armiothub.NewResourceClient(subscription, cred, nil)
+	iotHubClient, err := armiothub.NewResourceClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -162,14 +163,36 @@ func getIOTHub(ctx context.Context, client *armmonitor.DiagnosticSettingsClient,
 	return &resource, nil
 }
 
-func IOTHubDps(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+// IOTHubByID describes the IoT hub with the ARM id.
+func IOTHubByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armiothub.NewResourceClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getIOTHub(ctx, diagnosticClient, &resp.Description)
+}
+
+func IOTHubDps(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
 
-	clientFactory, err := armdeviceprovisioningservices.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armdeviceprovisioningservices.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -231,3 +254,25 @@ func getIOTHubDps(ctx context.Context, client *armmonitor.DiagnosticSettingsClie
 
 	return &resource, nil
 }
+
+// IOTHubDpsByID describes the IoT Hub device provisioning service with the ARM id.
+func IOTHubDpsByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdeviceprovisioningservices.NewIotDpsResourceClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.Name, id.ResourceGroupName, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getIOTHubDps(ctx, diagnosticClient, &resp.ProvisioningServiceDescription)
+}
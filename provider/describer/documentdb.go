@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/cosmos/armcosmos/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,13 +12,13 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func DocumentDBSQLDatabase(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func DocumentDBSQLDatabase(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 	rgs, err := listResourceGroups(ctx, cred, subscription)
 	if err != nil {
 		return nil, err
 	}
 
-	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +60,31 @@ func DocumentDBSQLDatabase(ctx context.Context, cred *azidentity.ClientSecretCre
 	return values, nil
 }
 
+// DocumentDBSQLDatabaseByID describes the Cosmos DB SQL database with the ARM id.
+func DocumentDBSQLDatabaseByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcosmos.NewSQLResourcesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetSQLDatabase(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armcosmos.NewDatabaseAccountsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDocumentDBSQLDatabase(ctx, &resp.SQLDatabaseGetResults, &account.DatabaseAccountGetResults, armresources.ResourceGroup{Name: &id.ResourceGroupName}), nil
+}
+
 func getDocumentDBSQLDatabase(ctx context.Context, v *armcosmos.SQLDatabaseGetResults, account *armcosmos.DatabaseAccountGetResults, rg armresources.ResourceGroup) *models.Resource {
 	location := "global"
 	if v.Location != nil {
@@ -80,13 +106,13 @@ func getDocumentDBSQLDatabase(ctx context.Context, v *armcosmos.SQLDatabaseGetRe
 	return &resource
 }
 
-func DocumentDBMongoDatabase(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func DocumentDBMongoDatabase(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 	rgs, err := listResourceGroups(ctx, cred, subscription)
 	if err != nil {
 		return nil, err
 	}
 
-	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +152,31 @@ func DocumentDBMongoDatabase(ctx context.Context, cred *azidentity.ClientSecretC
 	return values, nil
 }
 
+// DocumentDBMongoDatabaseByID describes the Cosmos DB MongoDB database with the ARM id.
+func DocumentDBMongoDatabaseByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcosmos.NewMongoDBResourcesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetMongoDBDatabase(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armcosmos.NewDatabaseAccountsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDocumentDBMongoDatabase(ctx, &resp.MongoDBDatabaseGetResults, &account.DatabaseAccountGetResults, armresources.ResourceGroup{Name: &id.ResourceGroupName}), nil
+}
+
 func getDocumentDBMongoDatabase(ctx context.Context, v *armcosmos.MongoDBDatabaseGetResults, account *armcosmos.DatabaseAccountGetResults, rg armresources.ResourceGroup) *models.Resource {
 	location := ""
 	if v.Location != nil {
@@ -147,13 +198,13 @@ func getDocumentDBMongoDatabase(ctx context.Context, v *armcosmos.MongoDBDatabas
 	return &resource
 }
 
-func DocumentDBMongoCollection(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func DocumentDBMongoCollection(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 	rgs, err := listResourceGroups(ctx, cred, subscription)
 	if err != nil {
 		return nil, err
 	}
 
-	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -198,6 +249,35 @@ func DocumentDBMongoCollection(ctx context.Context, cred *azidentity.ClientSecre
 	return values, nil
 }
 
+// DocumentDBMongoCollectionByID describes the Cosmos DB MongoDB collection
+// with the ARM id.
+func DocumentDBMongoCollectionByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	accountName, databaseName := id.Parent.Parent.Name, id.Parent.Name
+	account, err := clientFactory.NewDatabaseAccountsClient().Get(ctx, id.ResourceGroupName, accountName, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	client := clientFactory.NewMongoDBResourcesClient()
+	db, err := client.GetMongoDBDatabase(ctx, id.ResourceGroupName, accountName, databaseName, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	collection, err := client.GetMongoDBCollection(ctx, id.ResourceGroupName, accountName, databaseName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	rg := armresources.ResourceGroup{Name: &id.ResourceGroupName}
+	return getDocumentDBMongoCollection(ctx, client, &collection.MongoDBCollectionGetResults, &account.DatabaseAccountGetResults, rg, &db.MongoDBDatabaseGetResults)
+}
+
 func ListDocumentDBMongoDatabaseCollections(ctx context.Context, client *armcosmos.MongoDBResourcesClient, rg armresources.ResourceGroup, account *armcosmos.DatabaseAccountGetResults, db *armcosmos.MongoDBDatabaseGetResults) ([]models.Resource, error) {
 	pager := client.NewListMongoDBCollectionsPager(*rg.Name, *account.Name, *db.Name, nil)
 	var values []models.Resource
@@ -244,8 +324,8 @@ func getDocumentDBMongoCollection(ctx context.Context, client *armcosmos.MongoDB
 	return &resource, nil
 }
 
-func DocumentDBCassandraCluster(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, nil)
+func DocumentDBCassandraCluster(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -272,6 +352,23 @@ func DocumentDBCassandraCluster(ctx context.Context, cred *azidentity.ClientSecr
 	return values, nil
 }
 
+// DocumentDBCassandraClusterByID describes the Cassandra cluster with the ARM id.
+func DocumentDBCassandraClusterByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcosmos.NewCassandraClustersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDocumentDBCassandraCluster(ctx, &resp.ClusterResource), nil
+}
+
 func getDocumentDBCassandraCluster(ctx context.Context, v *armcosmos.ClusterResource) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 	location := "global"
@@ -292,8 +389,8 @@ func getDocumentDBCassandraCluster(ctx context.Context, v *armcosmos.ClusterReso
 	return &resource
 }
 
-func documentDBDatabaseAccounts(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, resourceGroup string) ([]*armcosmos.DatabaseAccountGetResults, error) {
-	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, nil)
+func documentDBDatabaseAccounts(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceGroup string) ([]*armcosmos.DatabaseAccountGetResults, error) {
+	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -312,8 +409,8 @@ func documentDBDatabaseAccounts(ctx context.Context, cred *azidentity.ClientSecr
 	return values, nil
 }
 
-func CosmosdbAccount(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, nil)
+func CosmosdbAccount(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -340,6 +437,23 @@ func CosmosdbAccount(ctx context.Context, cred *azidentity.ClientSecretCredentia
 	return values, nil
 }
 
+// CosmosdbAccountByID describes the Cosmos DB account with the ARM id.
+func CosmosdbAccountByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcosmos.NewDatabaseAccountsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getCosmosdbAccount(ctx, &resp.DatabaseAccountGetResults), nil
+}
+
 func getCosmosdbAccount(ctx context.Context, v *armcosmos.DatabaseAccountGetResults) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 	location := ""
@@ -360,8 +474,8 @@ func getCosmosdbAccount(ctx context.Context, v *armcosmos.DatabaseAccountGetResu
 	return &resource
 }
 
-func CosmosdbRestorableDatabaseAccount(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, nil)
+func CosmosdbRestorableDatabaseAccount(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armcosmos.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -388,6 +502,23 @@ func CosmosdbRestorableDatabaseAccount(ctx context.Context, cred *azidentity.Cli
 	return values, nil
 }
 
+// CosmosdbRestorableDatabaseAccountByID describes the restorable Cosmos DB account with the ARM id.
+func CosmosdbRestorableDatabaseAccountByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcosmos.NewRestorableDatabaseAccountsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetByLocation(ctx, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getRestorableDatabaseAccount(ctx, &resp.RestorableDatabaseAccountGetResult), nil
+}
+
 func getRestorableDatabaseAccount(ctx context.Context, v *armcosmos.RestorableDatabaseAccountGetResult) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 	location := ""
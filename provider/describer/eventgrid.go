@@ -2,7 +2,9 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"encoding/json"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/eventgrid/armeventgrid/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,13 +13,13 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func EventGridDomainTopic(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func EventGridDomainTopic(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 	rgs, err := listResourceGroups(ctx, cred, subscription)
 	if err != nil {
 		return nil, err
 	}
 
-	clientFactory, err := armeventgrid.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armeventgrid.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -63,8 +65,8 @@ func getEventGridDomainTopic(ctx context.Context, v *armeventgrid.DomainTopic) *
 	}
 }
 
-func eventGridDomain(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, resourceGroup string) ([]*armeventgrid.Domain, error) {
-	clientFactory, err := armeventgrid.NewClientFactory(subscription, cred, nil)
+func eventGridDomain(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceGroup string) ([]*armeventgrid.Domain, error) {
+	clientFactory, err := armeventgrid.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -82,14 +84,14 @@ func eventGridDomain(ctx context.Context, cred *azidentity.ClientSecretCredentia
 	return values, nil
 }
 
-func EventGridDomain(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armeventgrid.NewClientFactory(subscription, cred, nil)
+func EventGridDomain(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armeventgrid.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewDomainsClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +121,21 @@ func EventGridDomain(ctx context.Context, cred *azidentity.ClientSecretCredentia
 	return values, nil
 }
 
+// EventGridDomainHydrator adds the diagnostic settings to a Resource Graph
+// row of a domain.
+func EventGridDomainHydrator(ctx context.Context, cred azcore.TokenCredential, subscription string, row json.RawMessage) (*models.Resource, error) {
+	var domain armeventgrid.Domain
+	if err := json.Unmarshal(row, &domain); err != nil {
+		return nil, err
+	}
+
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return getEventGridDomain(ctx, &domain, monitorClientFactory.NewDiagnosticSettingsClient())
+}
+
 func getEventGridDomain(ctx context.Context, domain *armeventgrid.Domain, client *armmonitor.DiagnosticSettingsClient) (*models.Resource, error) {
 	resourceGroup := strings.Split(*domain.ID, "/")[4]
 
@@ -148,14 +165,36 @@ func getEventGridDomain(ctx context.Context, domain *armeventgrid.Domain, client
 	return &resource, nil
 }
 
-func EventGridTopic(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armeventgrid.NewClientFactory(subscription, cred, nil)
+// EventGridDomainByID describes the Event Grid domain with the ARM id.
+func EventGridDomainByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armeventgrid.NewDomainsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getEventGridDomain(ctx, &resp.Domain, diagnosticClient)
+}
+
+func EventGridTopic(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armeventgrid.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewTopicsClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +224,21 @@ func EventGridTopic(ctx context.Context, cred *azidentity.ClientSecretCredential
 	return values, nil
 }
 
+// EventGridTopicHydrator adds the diagnostic settings to a Resource Graph row
+// of a topic.
+func EventGridTopicHydrator(ctx context.Context, cred azcore.TokenCredential, subscription string, row json.RawMessage) (*models.Resource, error) {
+	var topic armeventgrid.Topic
+	if err := json.Unmarshal(row, &topic); err != nil {
+		return nil, err
+	}
+
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return getEventGridTopic(ctx, &topic, monitorClientFactory.NewDiagnosticSettingsClient())
+}
+
 func getEventGridTopic(ctx context.Context, v *armeventgrid.Topic, client *armmonitor.DiagnosticSettingsClient) (*models.Resource, error) {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -213,3 +267,25 @@ func getEventGridTopic(ctx context.Context, v *armeventgrid.Topic, client *armmo
 	}
 	return &resource, nil
 }
+
+// EventGridTopicByID describes the Event Grid topic with the ARM id.
+func EventGridTopicByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armeventgrid.NewTopicsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getEventGridTopic(ctx, &resp.Topic, diagnosticClient)
+}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/eventhub/armeventhub"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,14 +12,14 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func EventhubNamespace(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+func EventhubNamespace(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
 
-	clientFactory, err := armeventhub.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armeventhub.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +58,9 @@ func getEventHubNamespace(ctx context.Context, diagnosticClient *armmonitor.Diag
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *namespace.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		insightsListOp = append(insightsListOp, page.Value...)
@@ -97,8 +101,30 @@ func getEventHubNamespace(ctx context.Context, diagnosticClient *armmonitor.Diag
 	return &resource, nil
 }
 
-func EventhubNamespaceEventhub(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armeventhub.NewClientFactory(subscription, cred, nil)
+// EventhubNamespaceByID describes the Event Hubs namespace with the ARM id.
+func EventhubNamespaceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armeventhub.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	client := clientFactory.NewNamespacesClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getEventHubNamespace(ctx, monitorClientFactory.NewDiagnosticSettingsClient(), client, clientFactory.NewPrivateEndpointConnectionsClient(), &resp.EHNamespace)
+}
+
+func EventhubNamespaceEventhub(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armeventhub.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -152,3 +178,28 @@ func getEventhubNamespaceEventhub(ctx context.Context, namespace *armeventhub.EH
 		},
 	}
 }
+
+// EventhubNamespaceEventhubByID describes the event hub with the ARM id.
+func EventhubNamespaceEventhubByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armeventhub.NewEventHubsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armeventhub.NewNamespacesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getEventhubNamespaceEventhub(ctx, &namespace.EHNamespace, &resp.Eventhub), nil
+}
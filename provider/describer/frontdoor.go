@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/frontdoor/armfrontdoor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,14 +12,14 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func FrontDoor(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armfrontdoor.NewClientFactory(subscription, cred, nil)
+func FrontDoor(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armfrontdoor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewFrontDoorsClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +57,9 @@ func getFrontDoor(ctx context.Context, diagnosticClient *armmonitor.DiagnosticSe
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *door.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		frontDoorListOp = append(frontDoorListOp, page.Value...)
@@ -75,3 +79,25 @@ func getFrontDoor(ctx context.Context, diagnosticClient *armmonitor.DiagnosticSe
 	}
 	return &resource, nil
 }
+
+// FrontDoorByID describes the Front Door with the ARM id.
+func FrontDoorByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armfrontdoor.NewFrontDoorsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getFrontDoor(ctx, diagnosticClient, &resp.FrontDoor)
+}
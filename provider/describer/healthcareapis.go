@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/healthcareapis/armhealthcareapis"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,15 +12,15 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func HealthcareService(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armhealthcareapis.NewClientFactory(subscription, cred, nil)
+func HealthcareService(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armhealthcareapis.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	privateEndpointClient := clientFactory.NewPrivateEndpointConnectionsClient()
 	client := clientFactory.NewServicesClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +62,9 @@ func getHealthcareService(ctx context.Context, privateEndpointClient *armhealthc
 		for pager.More() {
 			page, err := pager.NextPage(ctx)
 			if err != nil {
+				if Tolerate(ctx, *resourceId, "DiagnosticSettings.List", err) {
+					break
+				}
 				return nil, err
 			}
 			opValue = append(opValue, page.Value...)
@@ -100,3 +104,24 @@ func getHealthcareService(ctx context.Context, privateEndpointClient *armhealthc
 
 	return &resource, nil
 }
+
+// HealthcareServiceByID describes the healthcare service with the ARM id.
+func HealthcareServiceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armhealthcareapis.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewServicesClient().Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getHealthcareService(ctx, clientFactory.NewPrivateEndpointConnectionsClient(), monitorClientFactory.NewDiagnosticSettingsClient(), &resp.ServicesDescription)
+}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/hybridkubernetes/armhybridkubernetes"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/kubernetesconfiguration/armkubernetesconfiguration"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,14 +12,14 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func HybridKubernetesConnectedCluster(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armhybridkubernetes.NewClientFactory(subscription, cred, nil)
+func HybridKubernetesConnectedCluster(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armhybridkubernetes.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewConnectedClusterClient()
 
-	confClientFactory, err := armkubernetesconfiguration.NewClientFactory(subscription, cred, nil)
+	confClientFactory, err := armkubernetesconfiguration.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -75,3 +76,25 @@ func getHybridKubernetesConnectedCluster(ctx context.Context, extClient *armkube
 	}
 	return &resource, nil
 }
+
+// HybridKubernetesConnectedClusterByID describes the connected cluster with
+// the ARM id.
+func HybridKubernetesConnectedClusterByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armhybridkubernetes.NewConnectedClusterClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	confClientFactory, err := armkubernetesconfiguration.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getHybridKubernetesConnectedCluster(ctx, confClientFactory.NewExtensionsClient(), &resp.ConnectedCluster)
+}
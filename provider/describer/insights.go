@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"github.com/opengovern/og-describer-azure/provider/model"
@@ -10,8 +11,8 @@ import (
 	"time"
 )
 
-func DiagnosticSetting(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+func DiagnosticSetting(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +40,23 @@ func DiagnosticSetting(ctx context.Context, cred *azidentity.ClientSecretCredent
 	return values, nil
 }
 
+// DiagnosticSettingByID describes the diagnostic setting with the ARM id.
+func DiagnosticSettingByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmonitor.NewDiagnosticSettingsClient(cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.Parent.String(), id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDiagnosticSetting(ctx, &resp.DiagnosticSettingsResource), nil
+}
+
 func getDiagnosticSetting(ctx context.Context, diagnosticSetting *armmonitor.DiagnosticSettingsResource) *models.Resource {
 	var resourceGroup string
 	if diagnosticSetting.Properties.StorageAccountID != nil {
@@ -62,8 +80,8 @@ func getDiagnosticSetting(ctx context.Context, diagnosticSetting *armmonitor.Dia
 	return &resource
 }
 
-func LogAlert(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+func LogAlert(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +109,23 @@ func LogAlert(ctx context.Context, cred *azidentity.ClientSecretCredential, subs
 	return values, nil
 }
 
+// LogAlertByID describes the activity log alert with the ARM id.
+func LogAlertByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmonitor.NewActivityLogAlertsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLogAlert(ctx, &resp.ActivityLogAlertResource), nil
+}
+
 func getLogAlert(ctx context.Context, logAlert *armmonitor.ActivityLogAlertResource) *models.Resource {
 	resourceGroup := strings.Split(*logAlert.ID, "/")[4]
 
@@ -109,8 +144,8 @@ func getLogAlert(ctx context.Context, logAlert *armmonitor.ActivityLogAlertResou
 	return &resource
 }
 
-func LogProfile(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+func LogProfile(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -137,6 +172,23 @@ func LogProfile(ctx context.Context, cred *azidentity.ClientSecretCredential, su
 	return values, nil
 }
 
+// LogProfileByID describes the log profile with the ARM id.
+func LogProfileByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmonitor.NewLogProfilesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLogProfile(ctx, &resp.LogProfileResource), nil
+}
+
 func getLogProfile(ctx context.Context, logProfile *armmonitor.LogProfileResource) *models.Resource {
 	resourceGroup := strings.Split(*logProfile.ID, "/")[4]
 	location := "global"
@@ -184,8 +236,8 @@ func getMonitoringStartDateForGranularity(granularity string) string {
 	return time.Now().UTC().AddDate(0, 0, -5).Format(time.RFC3339)
 }
 
-func listAzureMonitorMetricStatistics(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, granularity string, metricNameSpace string, metricNames string, dimensionValue string) ([]model.MonitoringMetric, error) {
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+func listAzureMonitorMetricStatistics(ctx context.Context, cred azcore.TokenCredential, subscription string, granularity string, metricNameSpace string, metricNames string, dimensionValue string) ([]model.MonitoringMetric, error) {
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -236,8 +288,8 @@ func listAzureMonitorMetricStatistics(ctx context.Context, cred *azidentity.Clie
 	return values, nil
 }
 
-func AutoscaleSetting(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+func AutoscaleSetting(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -264,6 +316,24 @@ func AutoscaleSetting(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// AutoscaleSettingByID describes the autoscale setting with the ARM id.
+func AutoscaleSettingByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmonitor.NewAutoscaleSettingsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	resource := getAutoscaleSetting(&resp.AutoscaleSettingResource)
+	return &resource, nil
+}
+
 func getAutoscaleSetting(v *armmonitor.AutoscaleSettingResource) models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 	return models.Resource{
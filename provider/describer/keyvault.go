@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/keyvault/azcertificates"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
@@ -14,88 +15,103 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func KeyVaultKey(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, nil)
+// listKeyVaults lists the key vaults of the subscription, once per job.
+func listKeyVaults(ctx context.Context, cred azcore.TokenCredential, subscription string) ([]*armkeyvault.Resource, error) {
+	return cachedParents(ctx, "keyVaults/"+subscription, func() ([]*armkeyvault.Resource, error) {
+		client, err := armkeyvault.NewVaultsClient(subscription, cred, clientOptions(ctx))
+		if err != nil {
+			return nil, err
+		}
+		maxResults := int32(100)
+		pager := client.NewListPager(&armkeyvault.VaultsClientListOptions{
+			Top: &maxResults,
+		})
+		var values []*armkeyvault.Resource
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, page.Value...)
+		}
+		return values, nil
+	})
+}
+
+func KeyVaultKey(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	vaultsClient := clientFactory.NewVaultsClient()
 	keysClient := clientFactory.NewKeysClient()
 
-	maxResults := int32(100)
-	options := &armkeyvault.VaultsClientListOptions{
-		Top: &maxResults,
+	vaults, err := listKeyVaults(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
 	}
-	pager := vaultsClient.NewListPager(options)
 
 	wpe := concurrency.NewWorkPool(4)
 
 	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, v := range page.Value {
-			vault := v
-			wpe.AddJob(func() (interface{}, error) {
-				resourceGroup := strings.Split(*vault.ID, "/")[4]
-
-				pager2 := keysClient.NewListPager(resourceGroup, *vault.Name, nil)
-				var result []*armkeyvault.Key
-				for pager2.More() {
-					page2, err := pager2.NextPage(ctx)
+	for _, v := range vaults {
+		vault := v
+		wpe.AddJob(func() (interface{}, error) {
+			resourceGroup := strings.Split(*vault.ID, "/")[4]
+
+			pager2 := keysClient.NewListPager(resourceGroup, *vault.Name, nil)
+			var result []*armkeyvault.Key
+			for pager2.More() {
+				page2, err := pager2.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, page2.Value...)
+			}
+			wp := concurrency.NewWorkPool(8)
+			for _, r := range result {
+				resourceGroupCopy := resourceGroup
+				vaultCopy := vault
+				vCopy := r
+				wp.AddJob(func() (interface{}, error) {
+					op, err := keysClient.Get(ctx, resourceGroupCopy, *vaultCopy.Name, *vCopy.Name, nil)
 					if err != nil {
 						return nil, err
 					}
-					result = append(result, page2.Value...)
-				}
-				wp := concurrency.NewWorkPool(8)
-				for _, r := range result {
-					resourceGroupCopy := resourceGroup
-					vaultCopy := vault
-					vCopy := r
-					wp.AddJob(func() (interface{}, error) {
-						op, err := keysClient.Get(ctx, resourceGroupCopy, *vaultCopy.Name, *vCopy.Name, nil)
-						if err != nil {
-							return nil, err
-						}
-
-						// In some cases resource does not give any notFound error
-						// instead of notFound error, it returns empty data
-						if op.ID == nil {
-							return nil, nil
-						}
-
-						return models.Resource{
-							ID:       *vCopy.ID,
-							Name:     *vCopy.Name,
-							Location: *vCopy.Location,
-							Description: JSONAllFieldsMarshaller{
-								Value: model.KeyVaultKeyDescription{
-									Vault:         *vaultCopy,
-									Key:           *vCopy,
-									ResourceGroup: resourceGroupCopy,
-								},
-							},
-						}, nil
-					})
-				}
 
-				results := wp.Run()
-				var vvv []models.Resource
-				for _, r := range results {
-					if r.Error != nil {
-						return nil, err
-					}
-					if r.Value == nil {
-						continue
+					// In some cases resource does not give any notFound error
+					// instead of notFound error, it returns empty data
+					if op.ID == nil {
+						return nil, nil
 					}
-					vvv = append(vvv, r.Value.(models.Resource))
+
+					return models.Resource{
+						ID:       *vCopy.ID,
+						Name:     *vCopy.Name,
+						Location: *vCopy.Location,
+						Description: JSONAllFieldsMarshaller{
+							Value: model.KeyVaultKeyDescription{
+								Vault:         *vaultCopy,
+								Key:           *vCopy,
+								ResourceGroup: resourceGroupCopy,
+							},
+						},
+					}, nil
+				})
+			}
+
+			results := wp.Run()
+			var vvv []models.Resource
+			for _, r := range results {
+				if r.Error != nil {
+					return nil, err
 				}
-				return vvv, nil
-			})
-		}
+				if r.Value == nil {
+					continue
+				}
+				vvv = append(vvv, r.Value.(models.Resource))
+			}
+			return vvv, nil
+		})
 	}
 
 	results := wpe.Run()
@@ -120,6 +136,34 @@ func KeyVaultKey(ctx context.Context, cred *azidentity.ClientSecretCredential, s
 	return values, nil
 }
 
+// KeyVaultKeyByID describes the key vault key with the ARM id.
+func KeyVaultKeyByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	vault, err := clientFactory.NewVaultsClient().Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	keysClient := clientFactory.NewKeysClient()
+	key, err := keysClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getKeyVaultKey(ctx, keysClient, &key.Key, id.ResourceGroupName, &armkeyvault.Resource{
+		ID:       vault.ID,
+		Location: vault.Location,
+		Name:     vault.Name,
+		Tags:     vault.Tags,
+		Type:     vault.Type,
+	})
+}
+
 func getKeyVaultKey(ctx context.Context, keysClient *armkeyvault.KeysClient, vCopy *armkeyvault.Key, resourceGroupCopy string, vaultCopy *armkeyvault.Resource) (*models.Resource, error) {
 	op, err := keysClient.Get(ctx, resourceGroupCopy, *vaultCopy.Name, *vCopy.Name, nil)
 	if err != nil {
@@ -146,45 +190,54 @@ func getKeyVaultKey(ctx context.Context, keysClient *armkeyvault.KeysClient, vCo
 	}, nil
 }
 
-func KeyVault(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, nil)
+func KeyVault(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	vaults, err := listKeyVaults(ctx, cred, subscription)
 	if err != nil {
 		return nil, err
 	}
-	vaultsClient := clientFactory.NewVaultsClient()
+	return describeKeyVaults(ctx, cred, subscription, vaults, stream)
+}
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+// KeyVaultByID describes the key vault with the ARM id.
+func KeyVaultByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
 	if err != nil {
 		return nil, err
 	}
-	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	client, err := armkeyvault.NewVaultsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	vault, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	resource := armkeyvault.Resource{
+		ID:       vault.ID,
+		Name:     vault.Name,
+		Location: vault.Location,
+		Tags:     vault.Tags,
+		Type:     vault.Type,
+	}
+	return firstResource(describeKeyVaults(ctx, cred, subscription, []*armkeyvault.Resource{&resource}, nil))
+}
 
-	maxResults := int32(100)
-	options := &armkeyvault.VaultsClientListOptions{
-		Top: &maxResults,
+func describeKeyVaults(ctx context.Context, cred azcore.TokenCredential, subscription string, vaults []*armkeyvault.Resource, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
 	}
-	var values []models.Resource
-	pager := vaultsClient.NewListPager(options)
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, vault := range page.Value {
-			resource, err := getKeyVault(ctx, vault, vaultsClient, diagnosticClient)
-			if err != nil {
-				return nil, err
-			}
-			if stream != nil {
-				if err := (*stream)(*resource); err != nil {
-					return nil, err
-				}
-			} else {
-				values = append(values, *resource)
-			}
-		}
+	vaultsClient := clientFactory.NewVaultsClient()
+
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
 	}
-	return values, nil
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+
+	return describeItems(ctx, vaults, stream, func(ctx context.Context, vault *armkeyvault.Resource) (*models.Resource, error) {
+		return getKeyVault(ctx, vault, vaultsClient, diagnosticClient)
+	})
 }
 
 func getKeyVault(ctx context.Context, vault *armkeyvault.Resource, vaultsClient *armkeyvault.VaultsClient, diagnosticClient *armmonitor.DiagnosticSettingsClient) (*models.Resource, error) {
@@ -204,6 +257,9 @@ func getKeyVault(ctx context.Context, vault *armkeyvault.Resource, vaultsClient
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *vault.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		insightsListOp = append(insightsListOp, page.Value...)
@@ -225,8 +281,8 @@ func getKeyVault(ctx context.Context, vault *armkeyvault.Resource, vaultsClient
 	return &resource, nil
 }
 
-func DeletedVault(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, nil)
+func DeletedVault(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -253,6 +309,23 @@ func DeletedVault(ctx context.Context, cred *azidentity.ClientSecretCredential,
 	return values, nil
 }
 
+// DeletedVaultByID describes the deleted key vault with the ARM id.
+func DeletedVaultByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armkeyvault.NewVaultsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetDeleted(ctx, id.Name, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getDeletedVault(ctx, &resp.DeletedVault), nil
+}
+
 func getDeletedVault(ctx context.Context, vault *armkeyvault.DeletedVault) *models.Resource {
 	resourceGroup := strings.Split(*vault.ID, "/")[4]
 
@@ -270,8 +343,8 @@ func getDeletedVault(ctx context.Context, vault *armkeyvault.DeletedVault) *mode
 	return &resource
 }
 
-func KeyVaultManagedHardwareSecurityModule(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+func KeyVaultManagedHardwareSecurityModule(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -279,7 +352,7 @@ func KeyVaultManagedHardwareSecurityModule(ctx context.Context, cred *azidentity
 
 	maxResults := int32(100)
 
-	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -313,6 +386,28 @@ func KeyVaultManagedHardwareSecurityModule(ctx context.Context, cred *azidentity
 	return values, nil
 }
 
+// KeyVaultManagedHardwareSecurityModuleByID describes the managed HSM with the ARM id.
+func KeyVaultManagedHardwareSecurityModuleByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armkeyvault.NewManagedHsmsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getKeyVaultManagedHardwareSecurityModule(ctx, diagnosticClient, &resp.ManagedHsm)
+}
+
 func getKeyVaultManagedHardwareSecurityModule(ctx context.Context, client *armmonitor.DiagnosticSettingsClient, vault *armkeyvault.ManagedHsm) (*models.Resource, error) {
 	resourceGroup := strings.Split(*vault.ID, "/")[4]
 
@@ -341,70 +436,62 @@ func getKeyVaultManagedHardwareSecurityModule(ctx context.Context, client *armmo
 	return &resource, nil
 }
 
-func KeyVaultKeyVersion(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, nil)
+func KeyVaultKeyVersion(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	vaultsClient := clientFactory.NewVaultsClient()
 	keysClient := clientFactory.NewKeysClient()
 
-	maxResults := int32(100)
-	options := &armkeyvault.VaultsClientListOptions{
-		Top: &maxResults,
+	vaults, err := listKeyVaults(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
 	}
-	pager := vaultsClient.NewListPager(options)
 
 	wpe := concurrency.NewWorkPool(4)
 
 	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, v := range page.Value {
-			vault := v
-			wpe.AddJob(func() (interface{}, error) {
-				resourceGroup := strings.Split(*vault.ID, "/")[4]
-
-				pager2 := keysClient.NewListPager(resourceGroup, *vault.Name, nil)
-				var result []*armkeyvault.Key
-				for pager2.More() {
-					page2, err := pager2.NextPage(ctx)
+	for _, v := range vaults {
+		vault := v
+		wpe.AddJob(func() (interface{}, error) {
+			resourceGroup := strings.Split(*vault.ID, "/")[4]
+
+			pager2 := keysClient.NewListPager(resourceGroup, *vault.Name, nil)
+			var result []*armkeyvault.Key
+			for pager2.More() {
+				page2, err := pager2.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, page2.Value...)
+			}
+			wp := concurrency.NewWorkPool(8)
+			for _, r := range result {
+				resourceGroupCopy := resourceGroup
+				vaultCopy := vault
+				vCopy := r
+				wp.AddJob(func() (interface{}, error) {
+					resources, err := ListKeyVaultKeyVersion(ctx, keysClient, vCopy, resourceGroupCopy, vaultCopy)
 					if err != nil {
 						return nil, err
 					}
-					result = append(result, page2.Value...)
-				}
-				wp := concurrency.NewWorkPool(8)
-				for _, r := range result {
-					resourceGroupCopy := resourceGroup
-					vaultCopy := vault
-					vCopy := r
-					wp.AddJob(func() (interface{}, error) {
-						resources, err := ListKeyVaultKeyVersion(ctx, keysClient, vCopy, resourceGroupCopy, vaultCopy)
-						if err != nil {
-							return nil, err
-						}
-						return resources, nil
-					})
-				}
+					return resources, nil
+				})
+			}
 
-				results := wp.Run()
-				var vvv []models.Resource
-				for _, r := range results {
-					if r.Error != nil {
-						return nil, err
-					}
-					if r.Value == nil {
-						continue
-					}
-					vvv = append(vvv, r.Value.(models.Resource))
+			results := wp.Run()
+			var vvv []models.Resource
+			for _, r := range results {
+				if r.Error != nil {
+					return nil, err
 				}
-				return vvv, nil
-			})
-		}
+				if r.Value == nil {
+					continue
+				}
+				vvv = append(vvv, r.Value.(models.Resource))
+			}
+			return vvv, nil
+		})
 	}
 
 	results := wpe.Run()
@@ -473,44 +560,37 @@ func GetKeyVaultKeyVersion(ctx context.Context, resourceGroup string, vault *arm
 	return &resource
 }
 
-func KeyVaultCertificate(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, nil)
+func KeyVaultCertificate(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	vaultsClient := clientFactory.NewVaultsClient()
 
-	maxResults := int32(100)
-	options := &armkeyvault.VaultsClientListOptions{
-		Top: &maxResults,
-	}
 	var values []models.Resource
-	pager := vaultsClient.NewListPager(options)
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
+	vaults, err := listKeyVaults(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
+	for _, vault := range vaults {
+		resource, err := getKeyVaultCertificates(ctx, cred, vault, vaultsClient)
 		if err != nil {
 			return nil, err
 		}
-		for _, vault := range page.Value {
-			resource, err := getKeyVaultCertificates(ctx, cred, vault, vaultsClient)
-			if err != nil {
-				return nil, err
-			}
-			for _, res := range resource {
-				if stream != nil {
-					if err := (*stream)(res); err != nil {
-						return nil, err
-					}
-				} else {
-					values = append(values, res)
+		for _, res := range resource {
+			if stream != nil {
+				if err := (*stream)(res); err != nil {
+					return nil, err
 				}
+			} else {
+				values = append(values, res)
 			}
 		}
 	}
 	return values, nil
 }
 
-func getKeyVaultCertificates(ctx context.Context, cred *azidentity.ClientSecretCredential, vault *armkeyvault.Resource, vaultsClient *armkeyvault.VaultsClient) ([]models.Resource, error) {
+func getKeyVaultCertificates(ctx context.Context, cred azcore.TokenCredential, vault *armkeyvault.Resource, vaultsClient *armkeyvault.VaultsClient) ([]models.Resource, error) {
 	name := *vault.Name
 	resourceGroup := strings.Split(*vault.ID, "/")[4]
 
@@ -519,7 +599,7 @@ func getKeyVaultCertificates(ctx context.Context, cred *azidentity.ClientSecretC
 		return nil, err
 	}
 
-	client, err := azcertificates.NewClient(*keyVaultGetOp.Vault.Properties.VaultURI, cred, nil)
+	client, err := azcertificates.NewClient(*keyVaultGetOp.Vault.Properties.VaultURI, cred, &azcertificates.ClientOptions{ClientOptions: clientOptions(ctx).ClientOptions})
 	if err != nil {
 		return nil, err
 	}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,13 +12,13 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func LoadBalancer(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, nil)
+func LoadBalancer(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -47,6 +48,28 @@ func LoadBalancer(ctx context.Context, cred *azidentity.ClientSecretCredential,
 	return values, nil
 }
 
+// LoadBalancerByID describes the load balancer with the ARM id.
+func LoadBalancerByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLoadBalancer(ctx, diagnosticClient, &resp.LoadBalancer)
+}
+
 func getLoadBalancer(ctx context.Context, diagnosticClient *armmonitor.DiagnosticSettingsClient, loadBalancer *armnetwork.LoadBalancer) (*models.Resource, error) {
 	resourceGroup := strings.Split(*loadBalancer.ID, "/")[4]
 
@@ -56,6 +79,9 @@ func getLoadBalancer(ctx context.Context, diagnosticClient *armmonitor.Diagnosti
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *loadBalancer.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		diagnosticSettings = append(diagnosticSettings, page.Value...)
@@ -76,13 +102,13 @@ func getLoadBalancer(ctx context.Context, diagnosticClient *armmonitor.Diagnosti
 	return &resource, nil
 }
 
-func LoadBalancerBackendAddressPool(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, nil)
+func LoadBalancerBackendAddressPool(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	addressClient, err := armnetwork.NewLoadBalancerBackendAddressPoolsClient(subscription, cred, nil)
+	addressClient, err := armnetwork.NewLoadBalancerBackendAddressPoolsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +139,31 @@ func LoadBalancerBackendAddressPool(ctx context.Context, cred *azidentity.Client
 	return values, nil
 }
 
+// LoadBalancerBackendAddressPoolByID describes the backend address pool with the ARM id.
+func LoadBalancerBackendAddressPoolByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewLoadBalancerBackendAddressPoolsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	loadBalancer, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLoadBalancerBackendAddressPools(ctx, &loadBalancer.LoadBalancer, &resp.BackendAddressPool), nil
+}
+
 func listLoadBalancerBackendAddressPools(ctx context.Context, addressClient *armnetwork.LoadBalancerBackendAddressPoolsClient, loadBalancer *armnetwork.LoadBalancer) ([]models.Resource, error) {
 	resourceGroup := strings.Split(*loadBalancer.ID, "/")[4]
 
@@ -152,12 +203,12 @@ func getLoadBalancerBackendAddressPools(ctx context.Context, loadBalancer *armne
 	return &resource
 }
 
-func LoadBalancerNatRule(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, nil)
+func LoadBalancerNatRule(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	natRulesClient, err := armnetwork.NewInboundNatRulesClient(subscription, cred, nil)
+	natRulesClient, err := armnetwork.NewInboundNatRulesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +239,31 @@ func LoadBalancerNatRule(ctx context.Context, cred *azidentity.ClientSecretCrede
 	return values, nil
 }
 
+// LoadBalancerNatRuleByID describes the inbound NAT rule with the ARM id.
+func LoadBalancerNatRuleByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewInboundNatRulesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	loadBalancer, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLoadBalancerNatRule(ctx, &loadBalancer.LoadBalancer, &resp.InboundNatRule), nil
+}
+
 func listLoadBalancerNatRules(ctx context.Context, natRulesClient *armnetwork.InboundNatRulesClient, loadBalancer *armnetwork.LoadBalancer) ([]models.Resource, error) {
 	resourceGroup := strings.Split(*loadBalancer.ID, "/")[4]
 
@@ -224,12 +300,12 @@ func getLoadBalancerNatRule(ctx context.Context, loadBalancer *armnetwork.LoadBa
 	return &resource
 }
 
-func LoadBalancerOutboundRule(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, nil)
+func LoadBalancerOutboundRule(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	outboundRulesClient, err := armnetwork.NewLoadBalancerOutboundRulesClient(subscription, cred, nil)
+	outboundRulesClient, err := armnetwork.NewLoadBalancerOutboundRulesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -260,6 +336,31 @@ func LoadBalancerOutboundRule(ctx context.Context, cred *azidentity.ClientSecret
 	return values, nil
 }
 
+// LoadBalancerOutboundRuleByID describes the outbound rule with the ARM id.
+func LoadBalancerOutboundRuleByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewLoadBalancerOutboundRulesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	loadBalancer, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLoadBalancerOutboundRule(ctx, &loadBalancer.LoadBalancer, &resp.OutboundRule), nil
+}
+
 func listLoadBalancerOutboundRules(ctx context.Context, outboundRulesClient *armnetwork.LoadBalancerOutboundRulesClient, loadBalancer *armnetwork.LoadBalancer) ([]models.Resource, error) {
 	resourceGroup := strings.Split(*loadBalancer.ID, "/")[4]
 
@@ -296,12 +397,12 @@ func getLoadBalancerOutboundRule(ctx context.Context, loadBalancer *armnetwork.L
 	return &resource
 }
 
-func LoadBalancerProbe(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, nil)
+func LoadBalancerProbe(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	probesClient, err := armnetwork.NewLoadBalancerProbesClient(subscription, cred, nil)
+	probesClient, err := armnetwork.NewLoadBalancerProbesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -332,6 +433,31 @@ func LoadBalancerProbe(ctx context.Context, cred *azidentity.ClientSecretCredent
 	return values, nil
 }
 
+// LoadBalancerProbeByID describes the load balancer probe with the ARM id.
+func LoadBalancerProbeByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewLoadBalancerProbesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	loadBalancer, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLoadBalancerProbe(ctx, &loadBalancer.LoadBalancer, &resp.Probe), nil
+}
+
 func listLoadBalancerProbes(ctx context.Context, probesClient *armnetwork.LoadBalancerProbesClient, loadBalancer *armnetwork.LoadBalancer) ([]models.Resource, error) {
 	resourceGroup := strings.Split(*loadBalancer.ID, "/")[4]
 
@@ -368,12 +494,12 @@ func getLoadBalancerProbe(ctx context.Context, loadBalancer *armnetwork.LoadBala
 	return &resource
 }
 
-func LoadBalancerRule(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, nil)
+func LoadBalancerRule(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	rulesClient, err := armnetwork.NewLoadBalancerLoadBalancingRulesClient(subscription, cred, nil)
+	rulesClient, err := armnetwork.NewLoadBalancerLoadBalancingRulesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -404,6 +530,31 @@ func LoadBalancerRule(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// LoadBalancerRuleByID describes the load balancing rule with the ARM id.
+func LoadBalancerRuleByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewLoadBalancerLoadBalancingRulesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armnetwork.NewLoadBalancersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	loadBalancer, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLoadBalancerRule(ctx, &loadBalancer.LoadBalancer, &resp.LoadBalancingRule), nil
+}
+
 func listLoadBalancerRules(ctx context.Context, rulesClient *armnetwork.LoadBalancerLoadBalancingRulesClient, loadBalancer *armnetwork.LoadBalancer) ([]models.Resource, error) {
 	resourceGroup := strings.Split(*loadBalancer.ID, "/")[4]
 
@@ -2,14 +2,14 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armlinks"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func ResourceLink(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armlinks.NewClientFactory(subscription, cred, nil)
+func ResourceLink(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armlinks.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -49,3 +49,16 @@ func getResourceLink(ctx context.Context, v *armlinks.ResourceLink) *models.Reso
 	}
 	return &resource
 }
+
+// ResourceLinkByID describes the resource link with the ARM id.
+func ResourceLinkByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	client, err := armlinks.NewResourceLinksClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, resourceID, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getResourceLink(ctx, &resp.ResourceLink), nil
+}
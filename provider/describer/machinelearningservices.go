@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/machinelearning/armmachinelearning"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,13 +12,13 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func MachineLearningWorkspace(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armmachinelearning.NewWorkspacesClient(subscription, cred, nil)
+func MachineLearningWorkspace(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armmachinelearning.NewWorkspacesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +56,9 @@ func getMachineLearningWorkspace(ctx context.Context, diagnosticClient *armmonit
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *workspace.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		machineLearningServicesListOp = append(machineLearningServicesListOp, page.Value...)
@@ -75,3 +79,25 @@ func getMachineLearningWorkspace(ctx context.Context, diagnosticClient *armmonit
 
 	return &resource, nil
 }
+
+// MachineLearningWorkspaceByID describes the machine learning workspace with the ARM id.
+func MachineLearningWorkspaceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmachinelearning.NewWorkspacesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getMachineLearningWorkspace(ctx, diagnosticClient, &resp.Workspace)
+}
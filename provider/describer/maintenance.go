@@ -5,14 +5,15 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/maintenance/armmaintenance"
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func MaintenanceConfiguration(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func MaintenanceConfiguration(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 
-	clientFactory, err := armmaintenance.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armmaintenance.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -60,3 +61,20 @@ func getMaintenanceConfiguration(ctx context.Context, configuration *armmaintena
 	}
 	return &resource, nil
 }
+
+// MaintenanceConfigurationByID describes the maintenance configuration with the ARM id.
+func MaintenanceConfigurationByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmaintenance.NewConfigurationsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getMaintenanceConfiguration(ctx, &resp.Configuration)
+}
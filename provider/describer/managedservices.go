@@ -6,13 +6,14 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/managedservices/armmanagedservices"
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func LighthouseDefinition(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armmanagedservices.NewClientFactory(cred, nil)
+func LighthouseDefinition(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armmanagedservices.NewClientFactory(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -60,8 +61,27 @@ func getLighthouseDefinition(_ context.Context, lighthouseDefinition *armmanaged
 	return &resource
 }
 
-func LighthouseAssignments(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armmanagedservices.NewClientFactory(cred, nil)
+// LighthouseDefinitionByID describes the Lighthouse registration definition
+// with the ARM id.
+func LighthouseDefinitionByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmanagedservices.NewRegistrationDefinitionsClient(cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	scope := fmt.Sprintf("subscriptions/%s", id.SubscriptionID)
+	resp, err := client.Get(ctx, scope, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLighthouseDefinition(ctx, &resp.RegistrationDefinition, scope), nil
+}
+
+func LighthouseAssignments(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armmanagedservices.NewClientFactory(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -110,3 +130,22 @@ func getLighthouseAssignment(_ context.Context, lighthouseAssignment *armmanaged
 	return &resource
 
 }
+
+// LighthouseAssignmentByID describes the Lighthouse registration assignment
+// with the ARM id.
+func LighthouseAssignmentByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmanagedservices.NewRegistrationAssignmentsClient(cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	scope := fmt.Sprintf("subscriptions/%s", id.SubscriptionID)
+	resp, err := client.Get(ctx, scope, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLighthouseAssignment(ctx, &resp.RegistrationAssignment, scope), nil
+}
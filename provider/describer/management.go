@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/managementgroups/armmanagementgroups"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armlocks"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,8 +12,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func ManagementGroup(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armmanagementgroups.NewClientFactory(cred, nil)
+func ManagementGroup(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armmanagementgroups.NewClientFactory(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -60,8 +61,25 @@ func getManagementGroup(ctx context.Context, client *armmanagementgroups.Client,
 	return resource, nil
 }
 
-func ManagementLock(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armlocks.NewClientFactory(subscription, cred, nil)
+// ManagementGroupByID describes the management group with the ARM id.
+func ManagementGroupByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmanagementgroups.NewClient(cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resource, err := getManagementGroup(ctx, client, &armmanagementgroups.ManagementGroupInfo{Name: &id.Name})
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return resource, nil
+}
+
+func ManagementLock(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armlocks.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -103,3 +121,45 @@ func getManagementLock(ctx context.Context, lockObject *armlocks.ManagementLockO
 	}
 	return &resource
 }
+
+// ManagementLockByID describes the management lock with the ARM id. A lock
+// can sit on the subscription, a resource group or a single resource.
+func ManagementLockByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armlocks.NewManagementLocksClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var lock armlocks.ManagementLockObject
+	scope := id.Parent
+	switch {
+	case strings.EqualFold(scope.ResourceType.String(), arm.SubscriptionResourceType.String()):
+		resp, err := client.GetAtSubscriptionLevel(ctx, id.Name, nil)
+		if err != nil {
+			return nil, resourceNotFound(err)
+		}
+		lock = resp.ManagementLockObject
+	case strings.EqualFold(scope.ResourceType.String(), arm.ResourceGroupResourceType.String()):
+		resp, err := client.GetAtResourceGroupLevel(ctx, id.ResourceGroupName, id.Name, nil)
+		if err != nil {
+			return nil, resourceNotFound(err)
+		}
+		lock = resp.ManagementLockObject
+	default:
+		var parentPath []string
+		for p := scope.Parent; p != nil && strings.EqualFold(p.ResourceType.Namespace, scope.ResourceType.Namespace); p = p.Parent {
+			parentPath = append([]string{p.ResourceType.Types[len(p.ResourceType.Types)-1], p.Name}, parentPath...)
+		}
+		resp, err := client.GetAtResourceLevel(ctx, id.ResourceGroupName, scope.ResourceType.Namespace, strings.Join(parentPath, "/"),
+			scope.ResourceType.Types[len(scope.ResourceType.Types)-1], scope.Name, id.Name, nil)
+		if err != nil {
+			return nil, resourceNotFound(err)
+		}
+		lock = resp.ManagementLockObject
+	}
+	return getManagementLock(ctx, &lock), nil
+}
@@ -0,0 +1,189 @@
+package describer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/managementgroups/armmanagementgroups"
+	"go.uber.org/zap"
+)
+
+var (
+	managementGroupScopeKey string = "management_group_scope"
+	managementGroupPathKey  string = "management_group_path"
+)
+
+type managementGroupScope struct {
+	groupID     string
+	concurrency int
+}
+
+// WithManagementGroupScope makes a job describe every subscription under the
+// management group, at any depth, at most concurrency of them at a time.
+func WithManagementGroupScope(ctx context.Context, groupID string, concurrency int) context.Context {
+	return context.WithValue(ctx, managementGroupScopeKey, managementGroupScope{groupID: groupID, concurrency: concurrency})
+}
+
+// GetManagementGroupScopeFromContext returns the group and the subscription
+// concurrency of a management group scoped job.
+func GetManagementGroupScopeFromContext(ctx context.Context) (string, int, bool) {
+	scope, ok := ctx.Value(managementGroupScopeKey).(managementGroupScope)
+	return scope.groupID, scope.concurrency, ok
+}
+
+// WithManagementGroupPath makes a subscription scoped job record the
+// management group ancestry of the subscription on its resources, which
+// tenant and management group scoped jobs always do.
+func WithManagementGroupPath(ctx context.Context) context.Context {
+	return context.WithValue(ctx, managementGroupPathKey, true)
+}
+
+// GetManagementGroupPathFromContext reports whether the job records the
+// management group ancestry whatever its scope.
+func GetManagementGroupPathFromContext(ctx context.Context) bool {
+	wanted, _ := ctx.Value(managementGroupPathKey).(bool)
+	return wanted
+}
+
+// ManagementGroupAncestry maps the subscriptions of the tenant to the names of
+// their management groups, from the root group down to the direct parent.
+type ManagementGroupAncestry map[string][]string
+
+// Under lists the subscriptions that have groupID among their ancestors.
+func (a ManagementGroupAncestry) Under(groupID string) []string {
+	var ids []string
+	for subscriptionID, chain := range a {
+		for _, name := range chain {
+			if strings.EqualFold(name, groupID) {
+				ids = append(ids, subscriptionID)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// Path is the ancestry of the subscription joined with '/', empty if it's
+// not known.
+func (a ManagementGroupAncestry) Path(subscriptionID string) string {
+	return strings.Join(a[strings.ToLower(subscriptionID)], "/")
+}
+
+const (
+	ancestryCacheTTL = time.Hour
+	// failures are remembered too, so that tenants where the hierarchy can't
+	// be read don't pay for it on every job
+	ancestryErrorTTL = 5 * time.Minute
+)
+
+type cachedAncestry struct {
+	ancestry  ManagementGroupAncestry
+	err       error
+	fetchedAt time.Time
+}
+
+func (c cachedAncestry) valid() bool {
+	ttl := ancestryCacheTTL
+	if c.err != nil {
+		ttl = ancestryErrorTTL
+	}
+	return time.Since(c.fetchedAt) < ttl
+}
+
+var (
+	ancestryCacheLock sync.Mutex
+	ancestryCache     = map[string]cachedAncestry{}
+)
+
+// GetManagementGroupAncestry lists the hierarchy visible to the credential.
+// It's cached per tenant and credential for a while since every job of the
+// tenant needs it and it rarely changes. credentialID tells the credentials
+// of a tenant apart, they may not see the same groups.
+//
+// A scoped job doesn't reuse a failure to read the hierarchy for lack of
+// permission, that is likely fixed by the time it's retried.
+func GetManagementGroupAncestry(ctx context.Context, cred azcore.TokenCredential, tenantID, credentialID string) (ManagementGroupAncestry, error) {
+	key := GetCloudFromContext(ctx).Name + "/" + tenantID + "/" + credentialID
+	ancestryCacheLock.Lock()
+	cached, ok := ancestryCache[key]
+	ancestryCacheLock.Unlock()
+	if ok && cached.valid() && !(isScopedJob(ctx) && isAuthorizationError(cached.err)) {
+		return cached.ancestry, cached.err
+	}
+
+	ancestry, err := listManagementGroupAncestry(ctx, cred)
+	if ctx.Err() == nil {
+		if !(isScopedJob(ctx) && isAuthorizationError(err)) {
+			ancestryCacheLock.Lock()
+			ancestryCache[key] = cachedAncestry{ancestry: ancestry, err: err, fetchedAt: time.Now()}
+			ancestryCacheLock.Unlock()
+		}
+		// logged once per cache entry, the jobs that use it only get the error
+		if err != nil {
+			logAncestryError(ctx, err)
+		}
+	}
+	return ancestry, err
+}
+
+// isScopedJob reports whether the job is tenant or management group scoped.
+func isScopedJob(ctx context.Context) bool {
+	if _, _, ok := GetManagementGroupScopeFromContext(ctx); ok {
+		return true
+	}
+	_, ok := GetTenantScopeFromContext(ctx)
+	return ok
+}
+
+func isAuthorizationError(err error) bool {
+	var respErr *azcore.ResponseError
+	return errors.As(err, &respErr) && (respErr.StatusCode == http.StatusUnauthorized || respErr.StatusCode == http.StatusForbidden)
+}
+
+// logAncestryError reports why the hierarchy couldn't be read. Credentials
+// are often not allowed to read it, which only leaves the management group
+// path out.
+func logAncestryError(ctx context.Context, err error) {
+	logger := GetLoggerFromContext(ctx)
+	if isAuthorizationError(err) {
+		logger.Debug("not allowed to read the management group hierarchy", zap.Error(err))
+		return
+	}
+	logger.Warn("failed to get management group ancestry", zap.Error(err))
+}
+
+func listManagementGroupAncestry(ctx context.Context, cred azcore.TokenCredential) (ManagementGroupAncestry, error) {
+	client, err := armmanagementgroups.NewEntitiesClient(cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	ancestry := ManagementGroupAncestry{}
+	pager := client.NewListPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, entity := range page.Value {
+			if entity.Type == nil || entity.Name == nil || entity.Properties == nil {
+				continue
+			}
+			if !strings.EqualFold(*entity.Type, "/subscriptions") {
+				continue
+			}
+			var chain []string
+			for _, name := range entity.Properties.ParentNameChain {
+				if name != nil {
+					chain = append(chain, *name)
+				}
+			}
+			ancestry[strings.ToLower(*entity.Name)] = chain
+		}
+	}
+	return ancestry, nil
+}
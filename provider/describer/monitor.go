@@ -5,13 +5,14 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func MonitorLogProfiles(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+func MonitorLogProfiles(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -60,3 +61,20 @@ func getMonitorLogProfile(ctx context.Context, logProfile *armmonitor.LogProfile
 	return &resource, nil
 
 }
+
+// MonitorLogProfileByID describes the log profile with the ARM id.
+func MonitorLogProfileByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmonitor.NewLogProfilesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getMonitorLogProfile(ctx, &resp.LogProfileResource)
+}
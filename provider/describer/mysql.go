@@ -5,15 +5,16 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/mysql/armmysql"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/mysql/armmysqlflexibleservers"
 
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func MysqlServer(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armmysql.NewClientFactory(subscription, cred, nil)
+func MysqlServer(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armmysql.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -109,8 +110,25 @@ func getMysqlServer(ctx context.Context, keysClient *armmysql.ServerKeysClient,
 	return &resource, nil
 }
 
-func MysqlFlexibleservers(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armmysqlflexibleservers.NewClientFactory(subscription, cred, nil)
+// MysqlServerByID describes the MySQL server with the ARM id.
+func MysqlServerByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armmysql.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewServersClient().Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getMysqlServer(ctx, clientFactory.NewServerKeysClient(), clientFactory.NewConfigurationsClient(), clientFactory.NewServerSecurityAlertPoliciesClient(), clientFactory.NewVirtualNetworkRulesClient(), &resp.Server)
+}
+
+func MysqlFlexibleservers(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armmysqlflexibleservers.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -153,3 +171,20 @@ func getMysqlFlexibleservers(ctx context.Context, server *armmysqlflexibleserver
 	}
 	return &resource
 }
+
+// MysqlFlexibleserverByID describes the MySQL flexible server with the ARM id.
+func MysqlFlexibleserverByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmysqlflexibleservers.NewServersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getMysqlFlexibleservers(ctx, &resp.Server), nil
+}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/netapp/armnetapp/v2"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func NetAppAccount(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetapp.NewAccountsClient(subscription, cred, nil)
+func NetAppAccount(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetapp.NewAccountsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -54,13 +55,30 @@ func getNetAppAccount(ctx context.Context, v *armnetapp.Account) *models.Resourc
 	return &resource
 }
 
-func NetAppCapacityPool(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetapp.NewAccountsClient(subscription, cred, nil)
+// NetAppAccountByID describes the NetApp account with the ARM id.
+func NetAppAccountByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
 	if err != nil {
 		return nil, err
 	}
+	client, err := armnetapp.NewAccountsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getNetAppAccount(ctx, &resp.Account), nil
+}
 
-	poolsClient, err := armnetapp.NewPoolsClient(subscription, cred, nil)
+func NetAppCapacityPool(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetapp.NewAccountsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	poolsClient, err := armnetapp.NewPoolsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -125,3 +143,28 @@ func getNetAppCapacityPool(ctx context.Context, v *armnetapp.Account, pool *armn
 	}
 	return &resource
 }
+
+// NetAppCapacityPoolByID describes the NetApp capacity pool with the ARM id.
+func NetAppCapacityPoolByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetapp.NewPoolsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armnetapp.NewAccountsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getNetAppCapacityPool(ctx, &account.Account, &resp.CapacityPool), nil
+}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dns/armdns"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dnsresolver/armdnsresolver"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
@@ -16,8 +17,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func NetworkInterface(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewInterfacesClient(subscription, cred, nil)
+func NetworkInterface(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewInterfacesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +44,23 @@ func NetworkInterface(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// NetworkInterfaceByID describes the network interface with the ARM id.
+func NetworkInterfaceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewInterfacesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getNetworkInterface(ctx, &resp.Interface), nil
+}
+
 func getNetworkInterface(ctx context.Context, v *armnetwork.Interface) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -60,12 +78,12 @@ func getNetworkInterface(ctx context.Context, v *armnetwork.Interface) *models.R
 	return &resource
 }
 
-func NetworkWatcherFlowLog(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	logsClient, err := armnetwork.NewFlowLogsClient(subscription, cred, nil)
+func NetworkWatcherFlowLog(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	logsClient, err := armnetwork.NewFlowLogsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	watcherClient, err := armnetwork.NewWatchersClient(subscription, cred, nil)
+	watcherClient, err := armnetwork.NewWatchersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +114,31 @@ func NetworkWatcherFlowLog(ctx context.Context, cred *azidentity.ClientSecretCre
 	return values, nil
 }
 
+// NetworkWatcherFlowLogByID describes the flow log with the ARM id.
+func NetworkWatcherFlowLogByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewFlowLogsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armnetwork.NewWatchersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	watcher, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getWatcherFlowLog(ctx, &watcher.Watcher, &resp.FlowLog), nil
+}
+
 func listWatcherFlowLogs(ctx context.Context, logsClient *armnetwork.FlowLogsClient, watcher *armnetwork.Watcher) ([]models.Resource, error) {
 	resourceGroupID := strings.Split(*watcher.ID, "/")[4]
 
@@ -132,12 +175,12 @@ func getWatcherFlowLog(ctx context.Context, watcher *armnetwork.Watcher, v *armn
 	return &resource
 }
 
-func Subnet(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	subnetsClient, err := armnetwork.NewSubnetsClient(subscription, cred, nil)
+func Subnet(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	subnetsClient, err := armnetwork.NewSubnetsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	virtualnetworkClient, err := armnetwork.NewVirtualNetworksClient(subscription, cred, nil)
+	virtualnetworkClient, err := armnetwork.NewVirtualNetworksClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -168,6 +211,31 @@ func Subnet(ctx context.Context, cred *azidentity.ClientSecretCredential, subscr
 	return values, nil
 }
 
+// SubnetByID describes the subnet with the ARM id.
+func SubnetByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewSubnetsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armnetwork.NewVirtualNetworksClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	virtualNetwork, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getVirtualNetworkSubnet(ctx, &virtualNetwork.VirtualNetwork, &resp.Subnet), nil
+}
+
 func listVirtualNetworkSubnets(ctx context.Context, subnetsClient *armnetwork.SubnetsClient, virtualnetwork *armnetwork.VirtualNetwork) ([]models.Resource, error) {
 	resourceGroupID := strings.Split(*virtualnetwork.ID, "/")[4]
 
@@ -204,8 +272,8 @@ func getVirtualNetworkSubnet(ctx context.Context, virtualnetwork *armnetwork.Vir
 	return &resource
 }
 
-func VirtualNetwork(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewVirtualNetworksClient(subscription, cred, nil)
+func VirtualNetwork(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewVirtualNetworksClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +299,23 @@ func VirtualNetwork(ctx context.Context, cred *azidentity.ClientSecretCredential
 	return values, nil
 }
 
+// VirtualNetworkByID describes the virtual network with the ARM id.
+func VirtualNetworkByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewVirtualNetworksClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getVirtualNetwork(ctx, &resp.VirtualNetwork), nil
+}
+
 func getVirtualNetwork(ctx context.Context, v *armnetwork.VirtualNetwork) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -249,13 +334,13 @@ func getVirtualNetwork(ctx context.Context, v *armnetwork.VirtualNetwork) *model
 	return &resource
 }
 
-func ApplicationGateway(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewApplicationGatewaysClient(subscription, cred, nil)
+func ApplicationGateway(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewApplicationGatewaysClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -285,6 +370,28 @@ func ApplicationGateway(ctx context.Context, cred *azidentity.ClientSecretCreden
 	return values, nil
 }
 
+// ApplicationGatewayByID describes the application gateway with the ARM id.
+func ApplicationGatewayByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewApplicationGatewaysClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getApplicationGateway(ctx, diagnosticClient, &resp.ApplicationGateway)
+}
+
 func getApplicationGateway(ctx context.Context, diagnosticClient *armmonitor.DiagnosticSettingsClient, gateway *armnetwork.ApplicationGateway) (*models.Resource, error) {
 	resourceGroup := strings.Split(*gateway.ID, "/")[4]
 
@@ -293,6 +400,9 @@ func getApplicationGateway(ctx context.Context, diagnosticClient *armmonitor.Dia
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *gateway.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		networkListOp = append(networkListOp, page.Value...)
@@ -313,13 +423,13 @@ func getApplicationGateway(ctx context.Context, diagnosticClient *armmonitor.Dia
 	return &resource, nil
 }
 
-func NetworkSecurityGroup(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewSecurityGroupsClient(subscription, cred, nil)
+func NetworkSecurityGroup(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewSecurityGroupsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -349,6 +459,28 @@ func NetworkSecurityGroup(ctx context.Context, cred *azidentity.ClientSecretCred
 	return values, nil
 }
 
+// NetworkSecurityGroupByID describes the network security group with the ARM id.
+func NetworkSecurityGroupByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewSecurityGroupsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getNetworkSecurityGroup(ctx, diagnosticClient, &resp.SecurityGroup)
+}
+
 func getNetworkSecurityGroup(ctx context.Context, diagnosticClient *armmonitor.DiagnosticSettingsClient, networkSecurityGroup *armnetwork.SecurityGroup) (*models.Resource, error) {
 	resourceGroup := strings.Split(*networkSecurityGroup.ID, "/")[4]
 
@@ -383,8 +515,8 @@ func getNetworkSecurityGroup(ctx context.Context, diagnosticClient *armmonitor.D
 	return &resource, nil
 }
 
-func NetworkWatcher(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewWatchersClient(subscription, cred, nil)
+func NetworkWatcher(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewWatchersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -410,6 +542,23 @@ func NetworkWatcher(ctx context.Context, cred *azidentity.ClientSecretCredential
 	return values, nil
 }
 
+// NetworkWatcherByID describes the network watcher with the ARM id.
+func NetworkWatcherByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewWatchersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getNetworkWatcher(ctx, &resp.Watcher), nil
+}
+
 func getNetworkWatcher(ctx context.Context, networkWatcher *armnetwork.Watcher) *models.Resource {
 	resourceGroup := strings.Split(*networkWatcher.ID, "/")[4]
 
@@ -428,8 +577,8 @@ func getNetworkWatcher(ctx context.Context, networkWatcher *armnetwork.Watcher)
 	return &resource
 }
 
-func RouteTables(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewRouteTablesClient(subscription, cred, nil)
+func RouteTables(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewRouteTablesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -455,6 +604,23 @@ func RouteTables(ctx context.Context, cred *azidentity.ClientSecretCredential, s
 	return values, nil
 }
 
+// RouteTableByID describes the route table with the ARM id.
+func RouteTableByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewRouteTablesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getRouteTable(ctx, &resp.RouteTable), nil
+}
+
 func getRouteTable(ctx context.Context, routeTable *armnetwork.RouteTable) *models.Resource {
 	resourceGroup := strings.Split(*routeTable.ID, "/")[4]
 
@@ -472,8 +638,8 @@ func getRouteTable(ctx context.Context, routeTable *armnetwork.RouteTable) *mode
 	return &resource
 }
 
-func NetworkApplicationSecurityGroups(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewApplicationSecurityGroupsClient(subscription, cred, nil)
+func NetworkApplicationSecurityGroups(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewApplicationSecurityGroupsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -499,6 +665,23 @@ func NetworkApplicationSecurityGroups(ctx context.Context, cred *azidentity.Clie
 	return values, nil
 }
 
+// NetworkApplicationSecurityGroupByID describes the application security group with the ARM id.
+func NetworkApplicationSecurityGroupByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewApplicationSecurityGroupsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getApplicationSecurityGroup(ctx, &resp.ApplicationSecurityGroup), nil
+}
+
 func getApplicationSecurityGroup(ctx context.Context, applicationSecurityGroup *armnetwork.ApplicationSecurityGroup) *models.Resource {
 	resourceGroup := strings.Split(*applicationSecurityGroup.ID, "/")[4]
 
@@ -517,8 +700,8 @@ func getApplicationSecurityGroup(ctx context.Context, applicationSecurityGroup *
 	return &resource
 }
 
-func NetworkAzureFirewall(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewAzureFirewallsClient(subscription, cred, nil)
+func NetworkAzureFirewall(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewAzureFirewallsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -544,6 +727,23 @@ func NetworkAzureFirewall(ctx context.Context, cred *azidentity.ClientSecretCred
 	return values, nil
 }
 
+// NetworkAzureFirewallByID describes the firewall with the ARM id.
+func NetworkAzureFirewallByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewAzureFirewallsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getAzureFirewall(ctx, &resp.AzureFirewall), nil
+}
+
 func getAzureFirewall(ctx context.Context, azureFirewall *armnetwork.AzureFirewall) *models.Resource {
 	resourceGroup := strings.Split(*azureFirewall.ID, "/")[4]
 
@@ -562,8 +762,8 @@ func getAzureFirewall(ctx context.Context, azureFirewall *armnetwork.AzureFirewa
 	return &resource
 }
 
-func ExpressRouteCircuit(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewExpressRouteCircuitsClient(subscription, cred, nil)
+func ExpressRouteCircuit(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewExpressRouteCircuitsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -589,6 +789,23 @@ func ExpressRouteCircuit(ctx context.Context, cred *azidentity.ClientSecretCrede
 	return values, nil
 }
 
+// ExpressRouteCircuitByID describes the ExpressRoute circuit with the ARM id.
+func ExpressRouteCircuitByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewExpressRouteCircuitsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getExpressRouteCircuit(ctx, &resp.ExpressRouteCircuit), nil
+}
+
 func getExpressRouteCircuit(ctx context.Context, expressRouteCircuit *armnetwork.ExpressRouteCircuit) *models.Resource {
 	resourceGroup := strings.Split(*expressRouteCircuit.ID, "/")[4]
 
@@ -606,8 +823,8 @@ func getExpressRouteCircuit(ctx context.Context, expressRouteCircuit *armnetwork
 	return &resource
 }
 
-func VirtualNetworkGateway(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewVirtualNetworkGatewaysClient(subscription, cred, nil)
+func VirtualNetworkGateway(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewVirtualNetworkGatewaysClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -636,6 +853,23 @@ func VirtualNetworkGateway(ctx context.Context, cred *azidentity.ClientSecretCre
 	return values, nil
 }
 
+// VirtualNetworkGatewayByID describes the virtual network gateway with the ARM id.
+func VirtualNetworkGatewayByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewVirtualNetworkGatewaysClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getVirtualNetworkGateway(ctx, client, &resp.VirtualNetworkGateway)
+}
+
 func getResourceGroupVirtualNetworkGateway(ctx context.Context, client *armnetwork.VirtualNetworkGatewaysClient, resourceGroup armresources.ResourceGroup) ([]models.Resource, error) {
 	pager := client.NewListPager(*resourceGroup.Name, nil)
 	var values []models.Resource
@@ -698,8 +932,8 @@ func getVirtualNetworkGateway(ctx context.Context, client *armnetwork.VirtualNet
 	return &resource, nil
 }
 
-func FirewallPolicy(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewFirewallPoliciesClient(subscription, cred, nil)
+func FirewallPolicy(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewFirewallPoliciesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -725,6 +959,23 @@ func FirewallPolicy(ctx context.Context, cred *azidentity.ClientSecretCredential
 	return values, nil
 }
 
+// FirewallPolicyByID describes the firewall policy with the ARM id.
+func FirewallPolicyByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewFirewallPoliciesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getFirewallPolicy(ctx, &resp.FirewallPolicy), nil
+}
+
 func getFirewallPolicy(ctx context.Context, firewallPolicy *armnetwork.FirewallPolicy) *models.Resource {
 	resourceGroup := strings.Split(*firewallPolicy.ID, "/")[4]
 
@@ -743,8 +994,8 @@ func getFirewallPolicy(ctx context.Context, firewallPolicy *armnetwork.FirewallP
 	return &resource
 }
 
-func LocalNetworkGateway(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewLocalNetworkGatewaysClient(subscription, cred, nil)
+func LocalNetworkGateway(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewLocalNetworkGatewaysClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -773,6 +1024,23 @@ func LocalNetworkGateway(ctx context.Context, cred *azidentity.ClientSecretCrede
 	return values, nil
 }
 
+// LocalNetworkGatewayByID describes the local network gateway with the ARM id.
+func LocalNetworkGatewayByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewLocalNetworkGatewaysClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getLocalNetworkGateway(ctx, &resp.LocalNetworkGateway), nil
+}
+
 func ListResourceGroupLocalNetworkGateways(ctx context.Context, client *armnetwork.LocalNetworkGatewaysClient, rg armresources.ResourceGroup) ([]models.Resource, error) {
 	var values []models.Resource
 	pager := client.NewListPager(*rg.Name, nil)
@@ -807,8 +1075,8 @@ func getLocalNetworkGateway(ctx context.Context, localNetworkGateway *armnetwork
 	return &resource
 }
 
-func NatGateway(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewNatGatewaysClient(subscription, cred, nil)
+func NatGateway(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewNatGatewaysClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -837,6 +1105,23 @@ func NatGateway(ctx context.Context, cred *azidentity.ClientSecretCredential, su
 	return values, nil
 }
 
+// NatGatewayByID describes the NAT gateway with the ARM id.
+func NatGatewayByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewNatGatewaysClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getNatGateway(ctx, &resp.NatGateway), nil
+}
+
 func ListResourceGroupNatGateways(ctx context.Context, client *armnetwork.NatGatewaysClient, rg armresources.ResourceGroup) ([]models.Resource, error) {
 	var values []models.Resource
 	pager := client.NewListPager(*rg.Name, nil)
@@ -871,8 +1156,8 @@ func getNatGateway(ctx context.Context, natGateway *armnetwork.NatGateway) *mode
 	return &resource
 }
 
-func PrivateLinkService(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewPrivateLinkServicesClient(subscription, cred, nil)
+func PrivateLinkService(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewPrivateLinkServicesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -901,6 +1186,23 @@ func PrivateLinkService(ctx context.Context, cred *azidentity.ClientSecretCreden
 	return values, nil
 }
 
+// PrivateLinkServiceByID describes the private link service with the ARM id.
+func PrivateLinkServiceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewPrivateLinkServicesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getPrivateLinkService(ctx, &resp.PrivateLinkService), nil
+}
+
 func ListResourceGroupPrivateLinkServices(ctx context.Context, client *armnetwork.PrivateLinkServicesClient, rg armresources.ResourceGroup) ([]models.Resource, error) {
 	var values []models.Resource
 	pager := client.NewListPager(*rg.Name, nil)
@@ -935,8 +1237,8 @@ func getPrivateLinkService(ctx context.Context, privateLinkService *armnetwork.P
 	return &resource
 }
 
-func RouteFilter(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewRouteFiltersClient(subscription, cred, nil)
+func RouteFilter(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewRouteFiltersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -980,8 +1282,8 @@ func getRouteFilter(ctx context.Context, routeFilter *armnetwork.RouteFilter) *m
 	return &resource
 }
 
-func VpnGateway(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewVPNGatewaysClient(subscription, cred, nil)
+func VpnGateway(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewVPNGatewaysClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1007,6 +1309,23 @@ func VpnGateway(ctx context.Context, cred *azidentity.ClientSecretCredential, su
 	return values, nil
 }
 
+// VpnGatewayByID describes the VPN gateway with the ARM id.
+func VpnGatewayByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewVPNGatewaysClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getVpnGateway(ctx, &resp.VPNGateway), nil
+}
+
 func getVpnGateway(ctx context.Context, vpnGateway *armnetwork.VPNGateway) *models.Resource {
 	resourceGroup := strings.Split(*vpnGateway.ID, "/")[4]
 
@@ -1025,12 +1344,12 @@ func getVpnGateway(ctx context.Context, vpnGateway *armnetwork.VPNGateway) *mode
 	return &resource
 }
 
-func NetworkVpnGatewaysVpnConnections(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewVPNGatewaysClient(subscription, cred, nil)
+func NetworkVpnGatewaysVpnConnections(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewVPNGatewaysClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	connClient, err := armnetwork.NewVPNConnectionsClient(subscription, cred, nil)
+	connClient, err := armnetwork.NewVPNConnectionsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1061,6 +1380,31 @@ func NetworkVpnGatewaysVpnConnections(ctx context.Context, cred *azidentity.Clie
 	return values, nil
 }
 
+// NetworkVpnGatewaysVpnConnectionByID describes the VPN connection with the ARM id.
+func NetworkVpnGatewaysVpnConnectionByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewVPNConnectionsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armnetwork.NewVPNGatewaysClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	vpnGateway, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getNetworkVpnGatewaysVpnConnections(ctx, &vpnGateway.VPNGateway, &resp.VPNConnection), nil
+}
+
 func ListNetworkVpnGatewayVpnConnections(ctx context.Context, connClient *armnetwork.VPNConnectionsClient, vpnGateway *armnetwork.VPNGateway) ([]models.Resource, error) {
 	resourceGroup := strings.Split(*vpnGateway.ID, "/")[4]
 
@@ -1098,8 +1442,8 @@ func getNetworkVpnGatewaysVpnConnections(ctx context.Context, vpnGateway *armnet
 	return &resource
 }
 
-func NetworkVpnGatewaysVpnSites(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewVPNSitesClient(subscription, cred, nil)
+func NetworkVpnGatewaysVpnSites(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewVPNSitesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1119,6 +1463,23 @@ func NetworkVpnGatewaysVpnSites(ctx context.Context, cred *azidentity.ClientSecr
 	return values, nil
 }
 
+// NetworkVpnGatewaysVpnSiteByID describes the VPN site with the ARM id.
+func NetworkVpnGatewaysVpnSiteByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewVPNSitesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getNetworkVpnGatewaysVpnSites(ctx, &resp.VPNSite), nil
+}
+
 func getNetworkVpnGatewaysVpnSites(ctx context.Context, v *armnetwork.VPNSite) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -1137,8 +1498,8 @@ func getNetworkVpnGatewaysVpnSites(ctx context.Context, v *armnetwork.VPNSite) *
 	return &resource
 }
 
-func PublicIPAddress(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewPublicIPAddressesClient(subscription, cred, nil)
+func PublicIPAddress(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewPublicIPAddressesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1167,6 +1528,23 @@ func PublicIPAddress(ctx context.Context, cred *azidentity.ClientSecretCredentia
 	return values, nil
 }
 
+// PublicIPAddressByID describes the public IP address with the ARM id.
+func PublicIPAddressByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewPublicIPAddressesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getPublicIPAddress(ctx, armresources.ResourceGroup{Name: &id.ResourceGroupName}, &resp.PublicIPAddress), nil
+}
+
 func ListResourceGroupPublicIPAddresses(ctx context.Context, client *armnetwork.PublicIPAddressesClient, resourceGroup armresources.ResourceGroup) ([]models.Resource, error) {
 	pager := client.NewListPager(*resourceGroup.Name, nil)
 	var values []models.Resource
@@ -1198,8 +1576,8 @@ func getPublicIPAddress(ctx context.Context, resourceGroup armresources.Resource
 	return &resource
 }
 
-func PublicIPPrefix(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewPublicIPPrefixesClient(subscription, cred, nil)
+func PublicIPPrefix(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewPublicIPPrefixesClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1228,6 +1606,23 @@ func PublicIPPrefix(ctx context.Context, cred *azidentity.ClientSecretCredential
 	return values, nil
 }
 
+// PublicIPPrefixByID describes the public IP prefix with the ARM id.
+func PublicIPPrefixByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewPublicIPPrefixesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getPublicIPPrefix(ctx, armresources.ResourceGroup{Name: &id.ResourceGroupName}, &resp.PublicIPPrefix), nil
+}
+
 func ListResourceGroupPublicIPPrefixes(ctx context.Context, client *armnetwork.PublicIPPrefixesClient, resourceGroup armresources.ResourceGroup) ([]models.Resource, error) {
 	pager := client.NewListPager(*resourceGroup.Name, nil)
 	var values []models.Resource
@@ -1260,8 +1655,8 @@ func getPublicIPPrefix(ctx context.Context, resourceGroup armresources.ResourceG
 	return &resource
 }
 
-func DNSZones(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armdns.NewClientFactory(subscription, cred, nil)
+func DNSZones(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armdns.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1288,6 +1683,23 @@ func DNSZones(ctx context.Context, cred *azidentity.ClientSecretCredential, subs
 	return values, nil
 }
 
+// DNSZoneByID describes the DNS zone with the ARM id.
+func DNSZoneByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdns.NewZonesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	zone, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetDNSZone(ctx, &zone.Zone), nil
+}
+
 func GetDNSZone(ctx context.Context, dnsZone *armdns.Zone) *models.Resource {
 	resourceGroup := strings.Split(*dnsZone.ID, "/")[4]
 	resource := models.Resource{
@@ -1305,8 +1717,8 @@ func GetDNSZone(ctx context.Context, dnsZone *armdns.Zone) *models.Resource {
 	return &resource
 }
 
-func DNSResolvers(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armdnsresolver.NewClientFactory(subscription, cred, nil)
+func DNSResolvers(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armdnsresolver.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1332,6 +1744,23 @@ func DNSResolvers(ctx context.Context, cred *azidentity.ClientSecretCredential,
 	}
 	return values, nil
 }
+
+// DNSResolverByID describes the DNS resolver with the ARM id.
+func DNSResolverByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armdnsresolver.NewDNSResolversClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetDNSResolver(ctx, &resp.DNSResolver), nil
+}
 func GetDNSResolver(ctx context.Context, dnsResolver *armdnsresolver.DNSResolver) *models.Resource {
 	resourceGroup := strings.Split(*dnsResolver.ID, "/")[4]
 	resource := models.Resource{
@@ -1349,8 +1778,8 @@ func GetDNSResolver(ctx context.Context, dnsResolver *armdnsresolver.DNSResolver
 	return &resource
 }
 
-func TrafficManagerProfile(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armtrafficmanager.NewClientFactory(subscription, cred, nil)
+func TrafficManagerProfile(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armtrafficmanager.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1377,6 +1806,23 @@ func TrafficManagerProfile(ctx context.Context, cred *azidentity.ClientSecretCre
 	return values, nil
 }
 
+// TrafficManagerProfileByID describes the Traffic Manager profile with the ARM id.
+func TrafficManagerProfileByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armtrafficmanager.NewProfilesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetTrafficManagerProfile(ctx, &resp.Profile), nil
+}
+
 func GetTrafficManagerProfile(ctx context.Context, profile *armtrafficmanager.Profile) *models.Resource {
 	resourceGroup := strings.Split(*profile.ID, "/")[4]
 	resource := models.Resource{
@@ -1394,8 +1840,8 @@ func GetTrafficManagerProfile(ctx context.Context, profile *armtrafficmanager.Pr
 	return &resource
 }
 
-func PrivateDnsZones(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armprivatedns.NewClientFactory(subscription, cred, nil)
+func PrivateDnsZones(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armprivatedns.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1422,6 +1868,23 @@ func PrivateDnsZones(ctx context.Context, cred *azidentity.ClientSecretCredentia
 	return values, nil
 }
 
+// PrivateDnsZoneByID describes the private DNS zone with the ARM id.
+func PrivateDnsZoneByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armprivatedns.NewPrivateZonesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetPrivateDnsZone(ctx, &resp.PrivateZone), nil
+}
+
 func GetPrivateDnsZone(ctx context.Context, privateZone *armprivatedns.PrivateZone) *models.Resource {
 	resourceGroup := strings.Split(*privateZone.ID, "/")[4]
 	resource := models.Resource{
@@ -1439,8 +1902,8 @@ func GetPrivateDnsZone(ctx context.Context, privateZone *armprivatedns.PrivateZo
 	return &resource
 }
 
-func PrivateEndpoints(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewPrivateEndpointsClient(subscription, cred, nil)
+func PrivateEndpoints(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewPrivateEndpointsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1469,6 +1932,23 @@ func PrivateEndpoints(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// PrivateEndpointByID describes the private endpoint with the ARM id.
+func PrivateEndpointByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewPrivateEndpointsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetPrivateEndpoint(ctx, armresources.ResourceGroup{Name: &id.ResourceGroupName}, &resp.PrivateEndpoint), nil
+}
+
 func ListResourceGroupPrivateEndpoints(ctx context.Context, client *armnetwork.PrivateEndpointsClient, resourceGroup armresources.ResourceGroup) ([]models.Resource, error) {
 	pager := client.NewListPager(*resourceGroup.Name, nil)
 	var values []models.Resource
@@ -1501,8 +1981,8 @@ func GetPrivateEndpoint(ctx context.Context, resourceGroup armresources.Resource
 	return &resource
 }
 
-func NetworkBastionHosts(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewBastionHostsClient(subscription, cred, nil)
+func NetworkBastionHosts(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewBastionHostsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1528,6 +2008,23 @@ func NetworkBastionHosts(ctx context.Context, cred *azidentity.ClientSecretCrede
 	return values, nil
 }
 
+// NetworkBastionHostByID describes the bastion host with the ARM id.
+func NetworkBastionHostByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewBastionHostsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetBastionHost(ctx, &resp.BastionHost), nil
+}
+
 func GetBastionHost(ctx context.Context, v *armnetwork.BastionHost) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 	resource := models.Resource{
@@ -1545,8 +2042,8 @@ func GetBastionHost(ctx context.Context, v *armnetwork.BastionHost) *models.Reso
 	return &resource
 }
 
-func NetworkConnections(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewVirtualNetworkGatewayConnectionsClient(subscription, cred, nil)
+func NetworkConnections(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewVirtualNetworkGatewayConnectionsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1575,6 +2072,23 @@ func NetworkConnections(ctx context.Context, cred *azidentity.ClientSecretCreden
 	return values, nil
 }
 
+// NetworkConnectionByID describes the virtual network gateway connection with the ARM id.
+func NetworkConnectionByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewVirtualNetworkGatewayConnectionsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetNetworkConnection(ctx, armresources.ResourceGroup{Name: &id.ResourceGroupName}, &resp.VirtualNetworkGatewayConnection), nil
+}
+
 func ListResourceGroupNetworkCOnnections(ctx context.Context, client *armnetwork.VirtualNetworkGatewayConnectionsClient, resourceGroup armresources.ResourceGroup) ([]models.Resource, error) {
 	pager := client.NewListPager(*resourceGroup.Name, nil)
 	var values []models.Resource
@@ -1608,8 +2122,8 @@ func GetNetworkConnection(ctx context.Context, resourceGroup armresources.Resour
 	return &resource
 }
 
-func NetworkVirtualHubs(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewVirtualHubsClient(subscription, cred, nil)
+func NetworkVirtualHubs(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewVirtualHubsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1635,6 +2149,23 @@ func NetworkVirtualHubs(ctx context.Context, cred *azidentity.ClientSecretCreden
 	return values, nil
 }
 
+// NetworkVirtualHubByID describes the virtual hub with the ARM id.
+func NetworkVirtualHubByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewVirtualHubsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetNetworkVirtualHub(ctx, &resp.VirtualHub), nil
+}
+
 func GetNetworkVirtualHub(ctx context.Context, v *armnetwork.VirtualHub) *models.Resource {
 	resourceGroupName := strings.Split(*v.ID, "/")[4]
 	resource := models.Resource{
@@ -1652,8 +2183,8 @@ func GetNetworkVirtualHub(ctx context.Context, v *armnetwork.VirtualHub) *models
 	return &resource
 }
 
-func NetworkVirtualWans(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewVirtualWansClient(subscription, cred, nil)
+func NetworkVirtualWans(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewVirtualWansClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1679,6 +2210,23 @@ func NetworkVirtualWans(ctx context.Context, cred *azidentity.ClientSecretCreden
 	return values, nil
 }
 
+// NetworkVirtualWanByID describes the virtual WAN with the ARM id.
+func NetworkVirtualWanByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewVirtualWansClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetNetworkVirtualWan(ctx, &resp.VirtualWAN), nil
+}
+
 func GetNetworkVirtualWan(ctx context.Context, v *armnetwork.VirtualWAN) *models.Resource {
 	resourceGroupName := strings.Split(*v.ID, "/")[4]
 	resource := models.Resource{
@@ -1695,8 +2243,8 @@ func GetNetworkVirtualWan(ctx context.Context, v *armnetwork.VirtualWAN) *models
 	return &resource
 }
 
-func NetworkDDoSProtectionPlan(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := armnetwork.NewDdosProtectionPlansClient(subscription, cred, nil)
+func NetworkDDoSProtectionPlan(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := armnetwork.NewDdosProtectionPlansClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -1722,6 +2270,23 @@ func NetworkDDoSProtectionPlan(ctx context.Context, cred *azidentity.ClientSecre
 	return values, nil
 }
 
+// NetworkDDoSProtectionPlanByID describes the DDoS protection plan with the ARM id.
+func NetworkDDoSProtectionPlanByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armnetwork.NewDdosProtectionPlansClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetNetworkDDoSProtectionPlan(ctx, &resp.DdosProtectionPlan), nil
+}
+
 func GetNetworkDDoSProtectionPlan(ctx context.Context, v *armnetwork.DdosProtectionPlan) *models.Resource {
 	resourceGroupName := strings.Split(*v.ID, "/")[4]
 	resource := models.Resource{
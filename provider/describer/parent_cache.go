@@ -0,0 +1,85 @@
+package describer
+
+import (
+	"context"
+	"sync"
+)
+
+var (
+	parentCacheKey string = "parent_cache"
+)
+
+// ParentCache keeps the parents the describers of a job list, e.g. the
+// storage accounts of a subscription, so the types of the job that hang off
+// them list them once.
+type ParentCache struct {
+	lock    sync.Mutex
+	entries map[string]*parentEntry
+}
+
+type parentEntry struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+func NewParentCache() *ParentCache {
+	return &ParentCache{entries: map[string]*parentEntry{}}
+}
+
+func WithParentCache(ctx context.Context, cache *ParentCache) context.Context {
+	return context.WithValue(ctx, parentCacheKey, cache)
+}
+
+// GetParentCacheFromContext returns nil if the job has no cache, the parents
+// are listed every time then.
+func GetParentCacheFromContext(ctx context.Context) *ParentCache {
+	cache, _ := ctx.Value(parentCacheKey).(*ParentCache)
+	return cache
+}
+
+// cachedParents returns the parents under key, listing them once for all the
+// describers that ask for them. Failed listings aren't kept, a describer that
+// waited on one lists the parents again itself.
+func cachedParents[T any](ctx context.Context, key string, list func() (T, error)) (T, error) {
+	cache := GetParentCacheFromContext(ctx)
+	if cache == nil {
+		return list()
+	}
+
+	for {
+		cache.lock.Lock()
+		entry, ok := cache.entries[key]
+		if !ok {
+			entry = &parentEntry{done: make(chan struct{})}
+			cache.entries[key] = entry
+			cache.lock.Unlock()
+
+			entry.value, entry.err = list()
+			if entry.err != nil {
+				cache.lock.Lock()
+				delete(cache.entries, key)
+				cache.lock.Unlock()
+			}
+			close(entry.done)
+			if entry.err != nil {
+				var zero T
+				return zero, entry.err
+			}
+			return entry.value.(T), nil
+		}
+		cache.lock.Unlock()
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+		if entry.err == nil {
+			return entry.value.(T), nil
+		}
+		// the error is the other describer's, its context may have been the
+		// one cancelled
+	}
+}
@@ -0,0 +1,92 @@
+package describer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCachedParents(t *testing.T) {
+	ctx := WithParentCache(context.Background(), NewParentCache())
+
+	var calls int32
+	list := func() ([]string, error) {
+		atomic.AddInt32(&calls, 1)
+		return []string{"a", "b"}, nil
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			parents, err := cachedParents(ctx, "parents/sub", list)
+			if err != nil || len(parents) != 2 {
+				t.Errorf("got %v, %v", parents, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Fatalf("listed %d times, want 1", calls)
+	}
+
+	// failures are listed again
+	failed := 0
+	failing := func() ([]string, error) {
+		failed++
+		return nil, errors.New("throttled")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := cachedParents(ctx, "failing/sub", failing); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+	if failed != 2 {
+		t.Fatalf("listed %d times, want 2", failed)
+	}
+
+	// a describer waiting on a failed listing lists again
+	started, release := make(chan struct{}), make(chan struct{})
+	cancelled := func() ([]string, error) {
+		close(started)
+		<-release
+		return nil, context.Canceled
+	}
+	done := make(chan error)
+	go func() {
+		_, err := cachedParents(ctx, "cancelled/sub", cancelled)
+		done <- err
+	}()
+	<-started
+	calls = 0
+	go func() {
+		parents, err := cachedParents(ctx, "cancelled/sub", list)
+		if err == nil && len(parents) != 2 {
+			err = errors.New("missing parents")
+		}
+		done <- err
+	}()
+	close(release)
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v from the cancelled listing", err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("got %v after a failed listing", err)
+	}
+	if calls != 1 {
+		t.Fatalf("listed %d times after a failed listing, want 1", calls)
+	}
+
+	// without a cache every call lists
+	calls = 0
+	for i := 0; i < 2; i++ {
+		if _, err := cachedParents(context.Background(), "parents/sub", list); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("listed %d times, want 2", calls)
+	}
+}
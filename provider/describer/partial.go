@@ -0,0 +1,88 @@
+package describer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"go.uber.org/zap"
+)
+
+var (
+	failuresKey string = "partial_success_failures"
+)
+
+// Failure is a call for a single resource that failed, the resource was
+// skipped or described without what the call would have added.
+type Failure struct {
+	ResourceID string `json:"resourceId"`
+	Operation  string `json:"operation"`
+	Error      string `json:"error"`
+	ErrorCode  string `json:"errorCode,omitempty"`
+
+	Err error `json:"-"`
+}
+
+// Failures collects the failures of a job that runs in partial success mode.
+type Failures struct {
+	lock  sync.Mutex
+	items []Failure
+}
+
+func (f *Failures) add(failure Failure) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.items = append(f.items, failure)
+}
+
+func (f *Failures) List() []Failure {
+	if f == nil {
+		return nil
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]Failure(nil), f.items...)
+}
+
+// WithPartialSuccess makes the describers skip or degrade the resources
+// they can't fully describe instead of failing the job.
+func WithPartialSuccess(ctx context.Context) context.Context {
+	return context.WithValue(ctx, failuresKey, &Failures{})
+}
+
+// GetFailuresFromContext returns nil if the job doesn't run in partial
+// success mode.
+func GetFailuresFromContext(ctx context.Context) *Failures {
+	f, _ := ctx.Value(failuresKey).(*Failures)
+	return f
+}
+
+// Tolerate reports whether a describer may go on without the call that
+// failed with err, recording the failure if so. That is the case in partial
+// success mode for errors that concern the resource alone, such as a 403 or
+// a 404 on one of its child calls.
+func Tolerate(ctx context.Context, resourceID, operation string, err error) bool {
+	failures := GetFailuresFromContext(ctx)
+	if failures == nil || !isResourceError(err) {
+		return false
+	}
+	failures.add(Failure{
+		ResourceID: resourceID,
+		Operation:  operation,
+		Error:      err.Error(),
+		Err:        err,
+	})
+	GetLoggerFromContext(ctx).Warn("resource partially described",
+		zap.String("resourceID", resourceID), zap.String("operation", operation), zap.Error(err))
+	return true
+}
+
+func isResourceError(err error) bool {
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+	return respErr.StatusCode == http.StatusForbidden || respErr.StatusCode == http.StatusNotFound
+}
@@ -2,21 +2,21 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armpolicy"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func PolicyAssignment(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armpolicy.NewClientFactory(subscription, cred, nil)
+func PolicyAssignment(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armpolicy.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewAssignmentsClient()
 
-	resourceClient, err := armresources.NewClient(subscription, cred, nil)
+	resourceClient, err := armresources.NewClient(subscription, cred, clientOptions(ctx))
 
 	pager := client.NewListPager(nil)
 	var values []models.Resource
@@ -65,3 +65,20 @@ func GetPolicyAssignment(ctx context.Context, resourceClient *armresources.Clien
 
 	return &resource
 }
+
+// PolicyAssignmentByID describes the policy assignment with the ARM id.
+func PolicyAssignmentByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	client, err := armpolicy.NewAssignmentsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resourceClient, err := armresources.NewClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetByID(ctx, resourceID, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetPolicyAssignment(ctx, resourceClient, &resp.Assignment), nil
+}
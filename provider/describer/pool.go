@@ -0,0 +1,165 @@
+package describer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
+)
+
+var (
+	poolOptionsKey string = "pool_options"
+)
+
+const defaultItemParallelism = 8
+
+// PoolOptions control how describeItems fans out the per item calls of a
+// describer.
+type PoolOptions struct {
+	// Parallelism is how many items are described at a time.
+	Parallelism int
+	// Ordered streams the resources in the order of the items, otherwise
+	// they're streamed as soon as they're described.
+	Ordered bool
+}
+
+func WithPoolOptions(ctx context.Context, opts PoolOptions) context.Context {
+	return context.WithValue(ctx, poolOptionsKey, opts)
+}
+
+// GetPoolOptionsFromContext falls back to DESCRIBE_ITEM_PARALLELISM and
+// ordered streaming.
+func GetPoolOptionsFromContext(ctx context.Context) PoolOptions {
+	if opts, ok := ctx.Value(poolOptionsKey).(PoolOptions); ok && opts.Parallelism > 0 {
+		return opts
+	}
+	parallelism, err := strconv.Atoi(os.Getenv("DESCRIBE_ITEM_PARALLELISM"))
+	if err != nil || parallelism < 1 {
+		parallelism = defaultItemParallelism
+	}
+	return PoolOptions{Parallelism: parallelism, Ordered: true}
+}
+
+// describeItems describes items concurrently and streams the resources, or
+// returns them if stream is nil. describe may return a nil resource to skip
+// an item. The first error cancels the items that are still running and is
+// returned once they stop.
+func describeItems[T any](ctx context.Context, items []T, stream *models.StreamSender, describe func(context.Context, T) (*models.Resource, error)) ([]models.Resource, error) {
+	return describeItemsMulti(ctx, items, stream, func(ctx context.Context, item T) ([]models.Resource, error) {
+		resource, err := describe(ctx, item)
+		if err != nil || resource == nil {
+			return nil, err
+		}
+		return []models.Resource{*resource}, nil
+	})
+}
+
+// describeItemsMulti is describeItems for items that make any number of
+// resources, e.g. the databases of a server.
+func describeItemsMulti[T any](ctx context.Context, items []T, stream *models.StreamSender, describe func(context.Context, T) ([]models.Resource, error)) ([]models.Resource, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	opts := GetPoolOptionsFromContext(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		index     int
+		resources []models.Resource
+		err       error
+	}
+	indexes := make(chan int)
+	results := make(chan result)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(opts.Parallelism, len(items)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				resources, err := func() (resources []models.Resource, err error) {
+					defer func() {
+						if r := recover(); r != nil {
+							err = fmt.Errorf("paniced with %v", r)
+						}
+					}()
+					return describe(ctx, items[i])
+				}()
+				results <- result{index: i, resources: resources, err: err}
+			}
+		}()
+	}
+	go func() {
+		defer close(indexes)
+		for i := range items {
+			select {
+			case indexes <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// the stream is only called from here, so it doesn't need to be safe for
+	// concurrent use
+	var values []models.Resource
+	emit := func(resources []models.Resource) error {
+		if stream == nil {
+			values = append(values, resources...)
+			return nil
+		}
+		for _, resource := range resources {
+			if err := (*stream)(resource); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var firstErr error
+	pending := map[int][]models.Resource{}
+	next := 0
+	for r := range results {
+		if firstErr != nil {
+			continue
+		}
+		if r.err != nil {
+			firstErr = r.err
+			cancel()
+			continue
+		}
+		if !opts.Ordered {
+			if err := emit(r.resources); err != nil {
+				firstErr = err
+				cancel()
+			}
+			continue
+		}
+		pending[r.index] = r.resources
+		for {
+			resources, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			if err := emit(resources); err != nil {
+				firstErr = err
+				cancel()
+				break
+			}
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return values, nil
+}
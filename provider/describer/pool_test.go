@@ -0,0 +1,64 @@
+package describer
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
+)
+
+func TestDescribeItemsOrdered(t *testing.T) {
+	ctx := WithPoolOptions(context.Background(), PoolOptions{Parallelism: 4, Ordered: true})
+	items := []int{5, 1, 4, 2, 3, 0}
+
+	var streamed []string
+	stream := models.StreamSender(func(r models.Resource) error {
+		streamed = append(streamed, r.ID)
+		return nil
+	})
+	_, err := describeItems(ctx, items, &stream, func(ctx context.Context, item int) (*models.Resource, error) {
+		// later items finish first
+		time.Sleep(time.Duration(item) * time.Millisecond)
+		if item == 0 {
+			return nil, nil
+		}
+		return &models.Resource{ID: strconv.Itoa(item)}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"5", "1", "4", "2", "3"}
+	if len(streamed) != len(want) {
+		t.Fatalf("streamed %v, want %v", streamed, want)
+	}
+	for i := range want {
+		if streamed[i] != want[i] {
+			t.Fatalf("streamed %v, want %v", streamed, want)
+		}
+	}
+}
+
+func TestDescribeItemsError(t *testing.T) {
+	ctx := WithPoolOptions(context.Background(), PoolOptions{Parallelism: 2})
+	failure := errors.New("failed")
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+
+	values, err := describeItems(ctx, items, nil, func(ctx context.Context, item int) (*models.Resource, error) {
+		if item == 3 {
+			return nil, failure
+		}
+		return &models.Resource{ID: strconv.Itoa(item)}, nil
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("err = %v, want %v", err, failure)
+	}
+	if values != nil {
+		t.Errorf("values = %v, want none", values)
+	}
+}
@@ -5,15 +5,16 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresql"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/postgresql/armpostgresqlflexibleservers"
 
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func PostgresqlServer(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armpostgresql.NewClientFactory(subscription, cred, nil)
+func PostgresqlServer(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armpostgresql.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -121,14 +122,31 @@ func GetPostgresqlServer(ctx context.Context, firewallClient *armpostgresql.Fire
 	return &resource, nil
 }
 
-func PostgresqlFlexibleservers(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+// PostgresqlServerByID describes the PostgreSQL server with the ARM id.
+func PostgresqlServerByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armpostgresql.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewServersClient().Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetPostgresqlServer(ctx, clientFactory.NewFirewallRulesClient(), clientFactory.NewServerKeysClient(), clientFactory.NewConfigurationsClient(), clientFactory.NewServerAdministratorsClient(), clientFactory.NewServerSecurityAlertPoliciesClient(), &resp.Server)
+}
 
-	client, err := armpostgresqlflexibleservers.NewServersClient(subscription, cred, nil)
+func PostgresqlFlexibleservers(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+
+	client, err := armpostgresqlflexibleservers.NewServersClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	configurationsClient, err := armpostgresqlflexibleservers.NewConfigurationsClient(subscription, cred, nil)
+	configurationsClient, err := armpostgresqlflexibleservers.NewConfigurationsClient(subscription, cred, clientOptions(ctx))
 
 	pager := client.NewListPager(nil)
 	var values []models.Resource
@@ -181,3 +199,24 @@ func GetPostgresqlFlexibleserver(ctx context.Context, configurationsClient *armp
 	}
 	return &resource
 }
+
+// PostgresqlFlexibleserverByID describes the PostgreSQL flexible server with the ARM id.
+func PostgresqlFlexibleserverByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armpostgresqlflexibleservers.NewServersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	configurationsClient, err := armpostgresqlflexibleservers.NewConfigurationsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetPostgresqlFlexibleserver(ctx, configurationsClient, &resp.Server), nil
+}
@@ -0,0 +1,44 @@
+package describer
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+type progressKey struct{}
+
+// Progress records when a job last did useful work, the worker uses it to
+// tell a long running job from a stalled one.
+type Progress struct {
+	last atomic.Int64
+}
+
+func NewProgress() *Progress {
+	p := &Progress{}
+	p.Touch()
+	return p
+}
+
+func (p *Progress) Touch() {
+	if p == nil {
+		return
+	}
+	p.last.Store(time.Now().UnixNano())
+}
+
+// Idle is the time since the job last made progress.
+func (p *Progress) Idle() time.Duration {
+	return time.Since(time.Unix(0, p.last.Load()))
+}
+
+func WithProgress(ctx context.Context, p *Progress) context.Context {
+	return context.WithValue(ctx, progressKey{}, p)
+}
+
+// GetProgressFromContext returns nil if the job is not tracked, Touch is a
+// no-op on nil.
+func GetProgressFromContext(ctx context.Context) *Progress {
+	p, _ := ctx.Value(progressKey{}).(*Progress)
+	return p
+}
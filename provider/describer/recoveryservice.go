@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservices"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/recoveryservices/armrecoveryservicesbackup/v3"
@@ -13,14 +14,14 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func RecoveryServicesVault(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armrecoveryservices.NewClientFactory(subscription, cred, nil)
+func RecoveryServicesVault(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armrecoveryservices.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewVaultsClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +51,28 @@ func RecoveryServicesVault(ctx context.Context, cred *azidentity.ClientSecretCre
 	return values, nil
 }
 
+// RecoveryServicesVaultByID describes the Recovery Services vault with the ARM id.
+func RecoveryServicesVaultByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armrecoveryservices.NewVaultsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetRecoveryServicesVault(ctx, diagnosticClient, &resp.Vault)
+}
+
 func GetRecoveryServicesVault(ctx context.Context, diagnosticClient *armmonitor.DiagnosticSettingsClient, vault *armrecoveryservices.Vault) (*models.Resource, error) {
 	resourceGroup := strings.Split(*vault.ID, "/")[4]
 
@@ -58,6 +81,9 @@ func GetRecoveryServicesVault(ctx context.Context, diagnosticClient *armmonitor.
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *vault.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		diagnostic = append(diagnostic, page.Value...)
@@ -78,14 +104,14 @@ func GetRecoveryServicesVault(ctx context.Context, diagnosticClient *armmonitor.
 	return &resource, nil
 }
 
-func RecoveryServicesBackupJobs(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	vaultClientFactory, err := armrecoveryservices.NewClientFactory(subscription, cred, nil)
+func RecoveryServicesBackupJobs(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	vaultClientFactory, err := armrecoveryservices.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	vaultClient := vaultClientFactory.NewVaultsClient()
 
-	clientFactory, err := armrecoveryservicesbackup.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armrecoveryservicesbackup.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -215,14 +241,14 @@ func backupJobProperties(data *armrecoveryservicesbackup.JobResource) (map[strin
 	return output, nil
 }
 
-func RecoveryServicesBackupPolicies(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	vaultClientFactory, err := armrecoveryservices.NewClientFactory(subscription, cred, nil)
+func RecoveryServicesBackupPolicies(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	vaultClientFactory, err := armrecoveryservices.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	vaultClient := vaultClientFactory.NewVaultsClient()
 
-	clientFactory, err := armrecoveryservicesbackup.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armrecoveryservicesbackup.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -305,14 +331,14 @@ func GetRecoveryServicesBackupPolicy(policy *armrecoveryservicesbackup.Protectio
 	}
 }
 
-func RecoveryServicesBackupItem(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	vaultClientFactory, err := armrecoveryservices.NewClientFactory(subscription, cred, nil)
+func RecoveryServicesBackupItem(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	vaultClientFactory, err := armrecoveryservices.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	vaultClient := vaultClientFactory.NewVaultsClient()
 
-	clientFactory, err := armrecoveryservicesbackup.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armrecoveryservicesbackup.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redis/armredis/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/redisenterprise/armredisenterprise"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,8 +12,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func RedisCache(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armredis.NewClientFactory(subscription, cred, nil)
+func RedisCache(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armredis.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -55,8 +56,25 @@ func GetRedisCache(ctx context.Context, v *armredis.ResourceInfo) *models.Resour
 	return &resource
 }
 
-func CacheRedisEnterprise(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armredisenterprise.NewClientFactory(subscription, cred, nil)
+// RedisCacheByID describes the Redis cache with the ARM id.
+func RedisCacheByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armredis.NewClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetRedisCache(ctx, &resp.ResourceInfo), nil
+}
+
+func CacheRedisEnterprise(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armredisenterprise.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -98,3 +116,20 @@ func GetCacheRedisEnterprise(ctx context.Context, v *armredisenterprise.Cluster)
 	}
 	return &resource
 }
+
+// CacheRedisEnterpriseByID describes the Redis Enterprise cluster with the ARM id.
+func CacheRedisEnterpriseByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armredisenterprise.NewClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetCacheRedisEnterprise(ctx, &resp.Cluster), nil
+}
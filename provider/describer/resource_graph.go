@@ -2,12 +2,14 @@ package describer
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resourcegraph/armresourcegraph"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -19,16 +21,25 @@ import (
 
 const SubscriptionBatchSize = 100
 
+// ResourceGraphHydrator turns a Resource Graph row of the subscription into
+// the resource, with the same description the ARM describer of the type makes.
+// It may call ARM for what the row is missing and return nil to skip the row.
+type ResourceGraphHydrator func(ctx context.Context, cred azcore.TokenCredential, subscription string, row json.RawMessage) (*models.Resource, error)
+
 type GenericResourceGraph struct {
 	Table string
 	Type  string
+
+	// Describe makes the resources of the rows, they're described as the
+	// raw rows if it's nil.
+	Describe ResourceGraphHydrator
 }
 
-func (d GenericResourceGraph) DescribeResources(ctx context.Context, cred *azidentity.ClientSecretCredential, _ hamiltonAuth.Authorizer, tempSubscriptions []string, tenantId string, triggerType enums.DescribeTriggerType, stream *models.StreamSender) ([]models.Resource, error) {
+func (d GenericResourceGraph) DescribeResources(ctx context.Context, cred azcore.TokenCredential, _ hamiltonAuth.Authorizer, tempSubscriptions []string, tenantId string, triggerType enums.DescribeTriggerType, stream *models.StreamSender) ([]models.Resource, error) {
 	ctx = WithTriggerType(ctx, triggerType)
 	query := fmt.Sprintf("%s | where type == \"%s\"", d.Table, strings.ToLower(d.Type))
 
-	client, err := armresourcegraph.NewClient(cred, nil)
+	client, err := armresourcegraph.NewClient(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -66,39 +77,14 @@ func (d GenericResourceGraph) DescribeResources(ctx context.Context, cred *azide
 				return nil, err
 			}
 
-			// No Need to wait for quota
-			//
-			//quotaRemaining, untilResets, err := quota()
-			//if err != nil {
-			//	return nil, err
-			//}
-			//if quotaRemaining == 0 {
-			//	time.Sleep(untilResets)
-			//}
-
-			for _, v := range response.Data.([]interface{}) {
-				m := v.(map[string]interface{})
-				loc := "global"
-				if v, ok := m["location"]; ok {
-					if vStr, ok := v.(string); ok {
-						loc = vStr
-					}
-				}
-				resource := models.Resource{
-					ID:          m["id"].(string),
-					Location:    loc,
-					Description: v,
-				}
-				if stream != nil {
-					if err := (*stream)(resource); err != nil {
-						return nil, err
-					}
-				} else {
-					values = append(values, resource)
-				}
-
-				values = append(values)
+			// the throttling policy waits for the quota to reset
+			resources, err := describeItems(ctx, response.Data.([]interface{}), stream, func(ctx context.Context, row interface{}) (*models.Resource, error) {
+				return d.describeRow(ctx, cred, row)
+			})
+			if err != nil {
+				return nil, err
 			}
+			values = append(values, resources...)
 			first, skipToken = false, response.SkipToken
 		}
 	}
@@ -106,6 +92,72 @@ func (d GenericResourceGraph) DescribeResources(ctx context.Context, cred *azide
 	return values, nil
 }
 
+func (d GenericResourceGraph) describeRow(ctx context.Context, cred azcore.TokenCredential, row interface{}) (*models.Resource, error) {
+	m := row.(map[string]interface{})
+	if d.Describe == nil {
+		loc := "global"
+		if v, ok := m["location"].(string); ok && v != "" {
+			loc = v
+		}
+		name, _ := m["name"].(string)
+		return &models.Resource{
+			ID:          m["id"].(string),
+			Name:        name,
+			Location:    loc,
+			Description: row,
+		}, nil
+	}
+
+	raw, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	subscription, _ := m["subscriptionId"].(string)
+	return d.Describe(ctx, cred, subscription, raw)
+}
+
+// ResourceGraphDescription decodes the row into the ARM model of the D
+// description, for the types whose description is the ARM model and its
+// resource group, e.g. model.ComputeDiskDescription.
+func ResourceGraphDescription[D any](_ context.Context, _ azcore.TokenCredential, _ string, row json.RawMessage) (*models.Resource, error) {
+	var header struct {
+		ID       string `json:"id"`
+		Name     string `json:"name"`
+		Location string `json:"location"`
+	}
+	if err := json.Unmarshal(row, &header); err != nil {
+		return nil, err
+	}
+	if header.Location == "" {
+		header.Location = "global"
+	}
+
+	var description D
+	v := reflect.ValueOf(&description).Elem()
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T is not a description", description)
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if v.Type().Field(i).Name == "ResourceGroup" {
+			if parts := strings.Split(header.ID, "/"); len(parts) > 4 {
+				field.SetString(parts[4])
+			}
+			continue
+		}
+		if err := json.Unmarshal(row, field.Addr().Interface()); err != nil {
+			return nil, fmt.Errorf("decode %s: %w", header.ID, err)
+		}
+	}
+
+	return &models.Resource{
+		ID:          header.ID,
+		Name:        header.Name,
+		Location:    header.Location,
+		Description: JSONAllFieldsMarshaller{Value: description},
+	}, nil
+}
+
 // quota parses the Azure throttling headers.
 // See https://docs.microsoft.com/en-us/azure/governance/resource-graph/concepts/guidance-for-throttled-requests#understand-throttling-headers
 func quota(header http.Header) (int, time.Duration, error) {
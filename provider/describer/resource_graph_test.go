@@ -0,0 +1,36 @@
+package describer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/opengovern/og-describer-azure/provider/model"
+)
+
+func TestResourceGraphDescription(t *testing.T) {
+	row := json.RawMessage(`{
+		"id": "/subscriptions/sub/resourceGroups/RG1/providers/Microsoft.Compute/disks/disk1",
+		"name": "disk1",
+		"type": "microsoft.compute/disks",
+		"location": "westeurope",
+		"subscriptionId": "sub",
+		"resourceGroup": "rg1",
+		"properties": {"diskSizeGB": 32}
+	}`)
+
+	resource, err := ResourceGraphDescription[model.ComputeDiskDescription](context.Background(), nil, "sub", row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resource.Name != "disk1" || resource.Location != "westeurope" {
+		t.Fatalf("got %s in %s", resource.Name, resource.Location)
+	}
+	description := resource.Description.(JSONAllFieldsMarshaller).Value.(model.ComputeDiskDescription)
+	if description.ResourceGroup != "RG1" {
+		t.Errorf("resource group %s, want RG1", description.ResourceGroup)
+	}
+	if description.Disk.Properties == nil || description.Disk.Properties.DiskSizeGB == nil || *description.Disk.Properties.DiskSizeGB != 32 {
+		t.Errorf("disk properties not decoded: %+v", description.Disk.Properties)
+	}
+}
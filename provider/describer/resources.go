@@ -2,37 +2,66 @@ package describer
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
+	"net/http"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func listResourceGroups(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string) ([]armresources.ResourceGroup, error) {
-	clientFactory, err := armresources.NewClientFactory(subscription, cred, nil)
-	if err != nil {
-		return nil, err
-	}
-	client := clientFactory.NewResourceGroupsClient()
-	pager := client.NewListPager(nil)
-	var values []armresources.ResourceGroup
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
+func listResourceGroups(ctx context.Context, cred azcore.TokenCredential, subscription string) ([]armresources.ResourceGroup, error) {
+	return cachedParents(ctx, "resourceGroups/"+subscription, func() ([]armresources.ResourceGroup, error) {
+		clientFactory, err := armresources.NewClientFactory(subscription, cred, clientOptions(ctx))
 		if err != nil {
 			return nil, err
 		}
-		for _, v := range page.Value {
-			values = append(values, *v)
+		client := clientFactory.NewResourceGroupsClient()
+		pager := client.NewListPager(nil)
+		var values []armresources.ResourceGroup
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range page.Value {
+				values = append(values, *v)
+			}
 		}
+		return values, nil
+	})
+}
+
+// ErrResourceNotFound is returned by the get describers when Azure reports
+// that the resource they were asked for doesn't exist.
+var ErrResourceNotFound = errors.New("resource not found")
+
+// resourceNotFound marks the not found response to the Get of the resource
+// itself, a missing subscription doesn't make the resource gone.
+func resourceNotFound(err error) error {
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound && !strings.EqualFold(respErr.ErrorCode, "SubscriptionNotFound") {
+		return fmt.Errorf("%w: %w", ErrResourceNotFound, err)
 	}
-	return values, nil
+	return err
 }
 
-func ResourceProvider(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armresources.NewClientFactory(subscription, cred, nil)
+// firstResource is the resource a single item describe made, nil if the item
+// was skipped.
+func firstResource(resources []models.Resource, err error) (*models.Resource, error) {
+	if err != nil || len(resources) == 0 {
+		return nil, err
+	}
+	return &resources[0], nil
+}
+
+func ResourceProvider(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armresources.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +88,23 @@ func ResourceProvider(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// ResourceProviderByID describes the resource provider with the ARM id.
+func ResourceProviderByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armresources.NewProvidersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetResourceProvider(ctx, &resp.Provider), nil
+}
+
 func GetResourceProvider(ctx context.Context, provider *armresources.Provider) *models.Resource {
 	resource := models.Resource{
 		ID:       *provider.ID,
@@ -73,8 +119,8 @@ func GetResourceProvider(ctx context.Context, provider *armresources.Provider) *
 	return &resource
 }
 
-func ResourceGroup(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armresources.NewClientFactory(subscription, cred, nil)
+func ResourceGroup(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armresources.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +147,23 @@ func ResourceGroup(ctx context.Context, cred *azidentity.ClientSecretCredential,
 	return values, nil
 }
 
+// ResourceGroupByID describes the resource group with the ARM id.
+func ResourceGroupByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armresources.NewResourceGroupsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetResourceGroup(ctx, &resp.ResourceGroup), nil
+}
+
 func GetResourceGroup(ctx context.Context, group *armresources.ResourceGroup) *models.Resource {
 	resource := models.Resource{
 		ID:       *group.ID,
@@ -116,9 +179,9 @@ func GetResourceGroup(ctx context.Context, group *armresources.ResourceGroup) *m
 	return &resource
 }
 
-func Resources(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func Resources(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 
-	clientFactory, err := armresources.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armresources.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
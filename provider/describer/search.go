@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/search/armsearch"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -10,14 +11,14 @@ import (
 	"strings"
 )
 
-func SearchService(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsearch.NewClientFactory(subscription, cred, nil)
+func SearchService(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsearch.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewServicesClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +56,9 @@ func GetSearchService(ctx context.Context, diagnosticClient *armmonitor.Diagnost
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *id, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		searchListOp = append(searchListOp, page.Value...)
@@ -75,3 +79,25 @@ func GetSearchService(ctx context.Context, diagnosticClient *armmonitor.Diagnost
 	}
 	return &resource, nil
 }
+
+// SearchServiceByID describes the search service with the ARM id.
+func SearchServiceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsearch.NewServicesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSearchService(ctx, diagnosticClient, &resp.Service)
+}
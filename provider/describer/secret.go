@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func KeyVaultSecret(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, nil)
+func KeyVaultSecret(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armkeyvault.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -19,87 +20,54 @@ func KeyVaultSecret(ctx context.Context, cred *azidentity.ClientSecretCredential
 	vaultsClient := clientFactory.NewVaultsClient()
 	secretsClient := clientFactory.NewSecretsClient()
 
-	maxResults := int32(100)
-	options := armkeyvault.VaultsClientListOptions{
-		Top: &maxResults,
+	vaults, err := listKeyVaults(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
 	}
-	pager := vaultsClient.NewListPager(&options)
 	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
+	for _, vault := range vaults {
+		//vaultURI := "https://" + *vault.Name + ".vault.azure.net/"
+		maxResults := int32(25)
+		rgs, err := listResourceGroups(ctx, cred, subscription)
 		if err != nil {
 			return nil, err
 		}
-		for _, vault := range page.Value {
-			//vaultURI := "https://" + *vault.Name + ".vault.azure.net/"
-			maxResults := int32(25)
-			rgs, err := listResourceGroups(ctx, cred, subscription)
-			if err != nil {
-				return nil, err
+		for _, rg := range rgs {
+			options := armkeyvault.SecretsClientListOptions{
+				Top: &maxResults,
 			}
-			for _, rg := range rgs {
-				options := armkeyvault.SecretsClientListOptions{
-					Top: &maxResults,
-				}
-				var vaultName string
-				splited := strings.Split(*vault.Name, "/")
-				if len(splited) > 1 {
-					vaultName = splited[8]
-				} else {
-					vaultName = *vault.Name
+			var vaultName string
+			splited := strings.Split(*vault.Name, "/")
+			if len(splited) > 1 {
+				vaultName = splited[8]
+			} else {
+				vaultName = *vault.Name
+			}
+			pager := secretsClient.NewListPager(*rg.Name, vaultName, &options)
+			for pager.More() {
+				page, err := pager.NextPage(ctx)
+				if err != nil {
+					if strings.Contains(err.Error(), "could not be found") {
+						break
+					}
+					return nil, err
 				}
-				pager := secretsClient.NewListPager(*rg.Name, vaultName, &options)
-				for pager.More() {
-					page, err := pager.NextPage(ctx)
+				for _, sc := range page.Value {
+					name := *vault.Name
+					resourceGroup := strings.Split(*vault.ID, "/")[4]
+
+					keyVaultGetOp, err := vaultsClient.Get(ctx, resourceGroup, name, nil)
 					if err != nil {
-						if strings.Contains(err.Error(), "could not be found") {
-							break
-						}
 						return nil, err
 					}
-					for _, sc := range page.Value {
-						splitID := strings.Split(*sc.ID, "/")
-						splitVaultID := strings.Split(*vault.ID, "/")
-						akas := []string{"azure:///subscriptions/" + subscription + "/resourceGroups/" + splitVaultID[4] +
-							"/providers/Microsoft.KeyVault/vaults/" + *vault.Name + "/secrets/" + splitID[4],
-							"azure:///subscriptions/" + subscription + "/resourcegroups/" + splitVaultID[4] +
-								"/providers/microsoft.keyvault/vaults/" + *vault.Name + "/secrets/" + splitID[4]}
-
-						turbotData := map[string]interface{}{
-							"SubscriptionId": subscription,
-							"ResourceGroup":  splitVaultID[4],
-							"Location":       vault.Location,
-							"Akas":           akas,
-						}
 
-						name := *vault.Name
-						resourceGroup := strings.Split(*vault.ID, "/")[4]
-
-						keyVaultGetOp, err := vaultsClient.Get(ctx, resourceGroup, name, nil)
-						if err != nil {
+					resource := getKeyVaultSecret(subscription, *rg.Name, &keyVaultGetOp.Vault, sc)
+					if stream != nil {
+						if err := (*stream)(*resource); err != nil {
 							return nil, err
 						}
-
-						resource := models.Resource{
-							ID:       *sc.ID,
-							Name:     *sc.ID,
-							Location: "global",
-							Description: JSONAllFieldsMarshaller{
-								Value: model.KeyVaultSecretDescription{
-									SecretItem:    *sc,
-									Vault:         keyVaultGetOp.Vault,
-									TurboData:     turbotData,
-									ResourceGroup: *rg.Name,
-								},
-							},
-						}
-						if stream != nil {
-							if err := (*stream)(resource); err != nil {
-								return nil, err
-							}
-						} else {
-							values = append(values, resource)
-						}
+					} else {
+						values = append(values, *resource)
 					}
 				}
 			}
@@ -107,3 +75,59 @@ func KeyVaultSecret(ctx context.Context, cred *azidentity.ClientSecretCredential
 	}
 	return values, nil
 }
+
+func getKeyVaultSecret(subscription string, resourceGroup string, vault *armkeyvault.Vault, sc *armkeyvault.Secret) *models.Resource {
+	splitID := strings.Split(*sc.ID, "/")
+	splitVaultID := strings.Split(*vault.ID, "/")
+	akas := []string{"azure:///subscriptions/" + subscription + "/resourceGroups/" + splitVaultID[4] +
+		"/providers/Microsoft.KeyVault/vaults/" + *vault.Name + "/secrets/" + splitID[4],
+		"azure:///subscriptions/" + subscription + "/resourcegroups/" + splitVaultID[4] +
+			"/providers/microsoft.keyvault/vaults/" + *vault.Name + "/secrets/" + splitID[4]}
+
+	turbotData := map[string]interface{}{
+		"SubscriptionId": subscription,
+		"ResourceGroup":  splitVaultID[4],
+		"Location":       vault.Location,
+		"Akas":           akas,
+	}
+
+	resource := models.Resource{
+		ID:       *sc.ID,
+		Name:     *sc.ID,
+		Location: "global",
+		Description: JSONAllFieldsMarshaller{
+			Value: model.KeyVaultSecretDescription{
+				SecretItem:    *sc,
+				Vault:         *vault,
+				TurboData:     turbotData,
+				ResourceGroup: resourceGroup,
+			},
+		},
+	}
+	return &resource
+}
+
+// KeyVaultSecretByID describes the key vault secret with the ARM id.
+func KeyVaultSecretByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armkeyvault.NewSecretsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armkeyvault.NewVaultsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	vault, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return getKeyVaultSecret(subscription, id.ResourceGroupName, &vault.Vault, &resp.Secret), nil
+}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/security/armsecurity"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func SecurityCenterAutoProvisioning(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, nil)
+func SecurityCenterAutoProvisioning(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +39,23 @@ func SecurityCenterAutoProvisioning(ctx context.Context, cred *azidentity.Client
 	return values, nil
 }
 
+// SecurityCenterAutoProvisioningByID describes the auto provisioning setting with the ARM id.
+func SecurityCenterAutoProvisioningByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsecurity.NewAutoProvisioningSettingsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSecurityCenterAutoProvisioning(ctx, &resp.AutoProvisioningSetting), nil
+}
+
 func GetSecurityCenterAutoProvisioning(ctx context.Context, v *armsecurity.AutoProvisioningSetting) *models.Resource {
 	resource := models.Resource{
 		ID:       *v.ID,
@@ -53,8 +71,8 @@ func GetSecurityCenterAutoProvisioning(ctx context.Context, v *armsecurity.AutoP
 	return &resource
 }
 
-func SecurityCenterContact(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, nil)
+func SecurityCenterContact(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +99,23 @@ func SecurityCenterContact(ctx context.Context, cred *azidentity.ClientSecretCre
 	return values, nil
 }
 
+// SecurityCenterContactByID describes the security contact with the ARM id.
+func SecurityCenterContactByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsecurity.NewContactsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, armsecurity.SecurityContactName(id.Name), nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSecurityCenterContact(ctx, &resp.Contact), nil
+}
+
 func GetSecurityCenterContact(ctx context.Context, v *armsecurity.Contact) *models.Resource {
 	resource := models.Resource{
 		ID:       *v.ID,
@@ -95,8 +130,8 @@ func GetSecurityCenterContact(ctx context.Context, v *armsecurity.Contact) *mode
 	return &resource
 }
 
-func SecurityCenterJitNetworkAccessPolicy(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, nil)
+func SecurityCenterJitNetworkAccessPolicy(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +158,23 @@ func SecurityCenterJitNetworkAccessPolicy(ctx context.Context, cred *azidentity.
 	return values, nil
 }
 
+// SecurityCenterJitNetworkAccessPolicyByID describes the JIT network access policy with the ARM id.
+func SecurityCenterJitNetworkAccessPolicyByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsecurity.NewJitNetworkAccessPoliciesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSecurityCenterJitNetworkAccessPolicy(ctx, &resp.JitNetworkAccessPolicy), nil
+}
+
 func GetSecurityCenterJitNetworkAccessPolicy(ctx context.Context, v *armsecurity.JitNetworkAccessPolicy) *models.Resource {
 	resource := models.Resource{
 		ID:       *v.ID,
@@ -137,8 +189,8 @@ func GetSecurityCenterJitNetworkAccessPolicy(ctx context.Context, v *armsecurity
 	return &resource
 }
 
-func SecurityCenterSetting(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, nil)
+func SecurityCenterSetting(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +217,23 @@ func SecurityCenterSetting(ctx context.Context, cred *azidentity.ClientSecretCre
 	return values, nil
 }
 
+// SecurityCenterSettingByID describes the security setting with the ARM id.
+func SecurityCenterSettingByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsecurity.NewSettingsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, armsecurity.SettingNameAutoGenerated(id.Name), nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSecurityCenterSetting(ctx, resp.SettingClassification), nil
+}
+
 func GetSecurityCenterSetting(ctx context.Context, v armsecurity.SettingClassification) *models.Resource {
 	var settingStatus bool
 	if *v.GetSetting().Kind == armsecurity.SettingKindDataExportSettings {
@@ -186,8 +255,8 @@ func GetSecurityCenterSetting(ctx context.Context, v armsecurity.SettingClassifi
 	return &resource
 }
 
-func SecurityCenterSubscriptionPricing(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, nil)
+func SecurityCenterSubscriptionPricing(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -211,6 +280,23 @@ func SecurityCenterSubscriptionPricing(ctx context.Context, cred *azidentity.Cli
 	return values, nil
 }
 
+// SecurityCenterSubscriptionPricingByID describes the pricing with the ARM id.
+func SecurityCenterSubscriptionPricingByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsecurity.NewPricingsClient(cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, "subscriptions/"+id.SubscriptionID, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSecurityCenterSubscriptionPricing(ctx, &resp.Pricing), nil
+}
+
 func GetSecurityCenterSubscriptionPricing(ctx context.Context, v *armsecurity.Pricing) *models.Resource {
 	resource := models.Resource{
 		ID:       *v.ID,
@@ -225,8 +311,8 @@ func GetSecurityCenterSubscriptionPricing(ctx context.Context, v *armsecurity.Pr
 	return &resource
 }
 
-func SecurityCenterAutomation(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, nil)
+func SecurityCenterAutomation(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -253,6 +339,23 @@ func SecurityCenterAutomation(ctx context.Context, cred *azidentity.ClientSecret
 	return values, nil
 }
 
+// SecurityCenterAutomationByID describes the security automation with the ARM id.
+func SecurityCenterAutomationByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsecurity.NewAutomationsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSecurityCenterAutomation(ctx, &resp.Automation), nil
+}
+
 func GetSecurityCenterAutomation(ctx context.Context, v *armsecurity.Automation) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 	resource := models.Resource{
@@ -269,8 +372,8 @@ func GetSecurityCenterAutomation(ctx context.Context, v *armsecurity.Automation)
 	return &resource
 }
 
-func SecurityCenterSubAssessment(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, nil)
+func SecurityCenterSubAssessment(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsecurity.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -297,6 +400,23 @@ func SecurityCenterSubAssessment(ctx context.Context, cred *azidentity.ClientSec
 	return values, nil
 }
 
+// SecurityCenterSubAssessmentByID describes the sub-assessment with the ARM id.
+func SecurityCenterSubAssessmentByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsecurity.NewSubAssessmentsClient(cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, strings.TrimPrefix(id.Parent.Parent.String(), "/"), id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSecurityCenterSubAssessment(ctx, &resp.SubAssessment), nil
+}
+
 func GetSecurityCenterSubAssessment(ctx context.Context, v *armsecurity.SubAssessment) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -5,7 +5,8 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/servicebus/armservicebus"
@@ -13,13 +14,13 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func ServiceBusQueue(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func ServiceBusQueue(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 	rgs, err := listResourceGroups(ctx, cred, subscription)
 	if err != nil {
 		return nil, err
 	}
 
-	clientFactory, err := armservicebus.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armservicebus.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +45,7 @@ func ServiceBusQueue(ctx context.Context, cred *azidentity.ClientSecretCredentia
 	return values, nil
 }
 
-func ListResourceGroupServiceBusQueue(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, client *armservicebus.QueuesClient, rg armresources.ResourceGroup) ([]models.Resource, error) {
+func ListResourceGroupServiceBusQueue(ctx context.Context, cred azcore.TokenCredential, subscription string, client *armservicebus.QueuesClient, rg armresources.ResourceGroup) ([]models.Resource, error) {
 	ns, err := serviceBusNamespace(ctx, cred, subscription, *rg.Name)
 	if err != nil {
 		return nil, err
@@ -87,13 +88,13 @@ func GetServiceBusQueue(ctx context.Context, v *armservicebus.SBQueue) *models.R
 	return &resource
 }
 
-func ServiceBusTopic(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func ServiceBusTopic(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
 	rgs, err := listResourceGroups(ctx, cred, subscription)
 	if err != nil {
 		return nil, err
 	}
 
-	clientFactory, err := armservicebus.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armservicebus.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +119,7 @@ func ServiceBusTopic(ctx context.Context, cred *azidentity.ClientSecretCredentia
 	return values, nil
 }
 
-func ListResourceGroupServiceBusTopic(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, client *armservicebus.TopicsClient, rg armresources.ResourceGroup) ([]models.Resource, error) {
+func ListResourceGroupServiceBusTopic(ctx context.Context, cred azcore.TokenCredential, subscription string, client *armservicebus.TopicsClient, rg armresources.ResourceGroup) ([]models.Resource, error) {
 	ns, err := serviceBusNamespace(ctx, cred, subscription, *rg.Name)
 	if err != nil {
 		return nil, err
@@ -161,8 +162,8 @@ func GetServiceBusTopic(ctx context.Context, v *armservicebus.SBTopic) *models.R
 	return &resource
 }
 
-func serviceBusNamespace(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, resourceGroup string) ([]*armservicebus.SBNamespace, error) {
-	clientFactory, err := armservicebus.NewClientFactory(subscription, cred, nil)
+func serviceBusNamespace(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceGroup string) ([]*armservicebus.SBNamespace, error) {
+	clientFactory, err := armservicebus.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -180,8 +181,8 @@ func serviceBusNamespace(ctx context.Context, cred *azidentity.ClientSecretCrede
 	return values, nil
 }
 
-func ServicebusNamespace(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armservicebus.NewClientFactory(subscription, cred, nil)
+func ServicebusNamespace(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armservicebus.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +190,7 @@ func ServicebusNamespace(ctx context.Context, cred *azidentity.ClientSecretCrede
 	namespaceClient := clientFactory.NewNamespacesClient()
 	client := clientFactory.NewNamespacesClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -227,6 +228,9 @@ func GetServicebusNamespace(ctx context.Context, namespaceClient *armservicebus.
 	for pager1.More() {
 		page1, err := pager1.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *namespace.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		insightsListOp = append(insightsListOp, page1.Value...)
@@ -279,3 +283,25 @@ func GetServicebusNamespace(ctx context.Context, namespaceClient *armservicebus.
 	}
 	return &resource, nil
 }
+
+// ServicebusNamespaceByID describes the Service Bus namespace with the ARM id.
+func ServicebusNamespaceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armservicebus.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	namespaceClient := clientFactory.NewNamespacesClient()
+	resp, err := namespaceClient.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetServicebusNamespace(ctx, namespaceClient, clientFactory.NewPrivateEndpointConnectionsClient(), monitorClientFactory.NewDiagnosticSettingsClient(), &resp.SBNamespace)
+}
@@ -4,7 +4,8 @@ import (
 	"context"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
 
 	"strings"
@@ -12,8 +13,8 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func MssqlManagedInstance(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsql.NewClientFactory(subscription, cred, nil)
+func MssqlManagedInstance(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -46,6 +47,23 @@ func MssqlManagedInstance(ctx context.Context, cred *azidentity.ClientSecretCred
 	return values, nil
 }
 
+// MssqlManagedInstanceByID describes the SQL managed instance with the ARM id.
+func MssqlManagedInstanceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewManagedInstancesClient().Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetMssqlManagedInstance(ctx, clientFactory.NewManagedInstanceVulnerabilityAssessmentsClient(), clientFactory.NewManagedServerSecurityAlertPoliciesClient(), clientFactory.NewManagedInstanceEncryptionProtectorsClient(), &resp.ManagedInstance)
+}
+
 func GetMssqlManagedInstance(ctx context.Context, managedInstanceClient *armsql.ManagedInstanceVulnerabilityAssessmentsClient, managedServerClient *armsql.ManagedServerSecurityAlertPoliciesClient, managedInstanceEncClient *armsql.ManagedInstanceEncryptionProtectorsClient, managedInstance *armsql.ManagedInstance) (*models.Resource, error) {
 	resourceGroup := strings.Split(string(*managedInstance.ID), "/")[4]
 	managedInstanceName := *managedInstance.Name
@@ -98,8 +116,8 @@ func GetMssqlManagedInstance(ctx context.Context, managedInstanceClient *armsql.
 	return &resource, nil
 }
 
-func MssqlManagedInstanceDatabases(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsql.NewClientFactory(subscription, cred, nil)
+func MssqlManagedInstanceDatabases(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +150,31 @@ func MssqlManagedInstanceDatabases(ctx context.Context, cred *azidentity.ClientS
 	return values, nil
 }
 
+// MssqlManagedInstanceDatabaseByID describes the managed instance database with the ARM id.
+func MssqlManagedInstanceDatabaseByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsql.NewManagedDatabasesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armsql.NewManagedInstancesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	managedInstance, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetManagedInstanceDatabases(ctx, &managedInstance.ManagedInstance, &resp.ManagedDatabase), nil
+}
+
 func ListManagedInstanceDatabases(ctx context.Context, dbClient *armsql.ManagedDatabasesClient, managedInstance *armsql.ManagedInstance) ([]models.Resource, error) {
 	resourceGroup := strings.Split(*managedInstance.ID, "/")[4]
 
@@ -168,12 +211,11 @@ func GetManagedInstanceDatabases(ctx context.Context, managedInstance *armsql.Ma
 	return &resource
 }
 
-func SqlDatabase(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsql.NewClientFactory(subscription, cred, nil)
+func SqlDatabase(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	parentClient := clientFactory.NewServersClient()
 	databaseVulnerabilityScanClient := clientFactory.NewDatabaseVulnerabilityAssessmentScansClient()
 	databaseVulnerabilityClient := clientFactory.NewDatabaseVulnerabilityAssessmentsClient()
 	transparentDataClient := clientFactory.NewTransparentDataEncryptionsClient()
@@ -184,30 +226,35 @@ func SqlDatabase(ctx context.Context, cred *azidentity.ClientSecretCredential, s
 	recoverableClient := clientFactory.NewRecoverableDatabasesClient()
 	auditingPolicyClient := clientFactory.NewDatabaseBlobAuditingPoliciesClient()
 
-	pager := parentClient.NewListPager(nil)
-	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, server := range page.Value {
-			resources, err := ListServerSqlDatabases(ctx, recoverableClient, advisorsClient, databaseVulnerabilityScanClient, databaseVulnerabilityClient, transparentDataClient, longTermClient, databasesClientClient, auditingPolicyClient, client, server)
-			if err != nil {
-				return nil, err
-			}
-			for _, resource := range resources {
-				if stream != nil {
-					if err := (*stream)(resource); err != nil {
-						return nil, err
-					}
-				} else {
-					values = append(values, resource)
-				}
-			}
-		}
+	servers, err := listSqlServers(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
 	}
-	return values, nil
+	return describeItemsMulti(ctx, servers, stream, func(ctx context.Context, server *armsql.Server) ([]models.Resource, error) {
+		return ListServerSqlDatabases(ctx, recoverableClient, advisorsClient, databaseVulnerabilityScanClient, databaseVulnerabilityClient, transparentDataClient, longTermClient, databasesClientClient, auditingPolicyClient, client, server)
+	})
+}
+
+// SqlDatabaseByID describes the SQL database with the ARM id.
+func SqlDatabaseByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	server, err := clientFactory.NewServersClient().Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	databasesClient := clientFactory.NewDatabasesClient()
+	database, err := databasesClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSqlDatabase(ctx, clientFactory.NewRecoverableDatabasesClient(), clientFactory.NewDatabaseAdvisorsClient(), clientFactory.NewDatabaseVulnerabilityAssessmentScansClient(), clientFactory.NewDatabaseVulnerabilityAssessmentsClient(), clientFactory.NewTransparentDataEncryptionsClient(), clientFactory.NewLongTermRetentionPoliciesClient(), databasesClient, clientFactory.NewDatabaseBlobAuditingPoliciesClient(), &server.Server, &database.Database)
 }
 
 func ListServerSqlDatabases(ctx context.Context, recoverableClient *armsql.RecoverableDatabasesClient, advisorsClient *armsql.DatabaseAdvisorsClient, databaseVulnerabilityScanClient *armsql.DatabaseVulnerabilityAssessmentScansClient, databaseVulnerabilityClient *armsql.DatabaseVulnerabilityAssessmentsClient, transparentDataClient *armsql.TransparentDataEncryptionsClient, longTermClient *armsql.LongTermRetentionPoliciesClient, databasesClientClient *armsql.DatabasesClient, auditingPoliciesClient *armsql.DatabaseBlobAuditingPoliciesClient, client *armsql.DatabasesClient, server *armsql.Server) ([]models.Resource, error) {
@@ -323,8 +370,8 @@ func GetSqlDatabase(ctx context.Context, recoverableClient *armsql.RecoverableDa
 	return &resource, nil
 }
 
-func SqlInstancePool(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsql.NewClientFactory(subscription, cred, nil)
+func SqlInstancePool(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -348,6 +395,23 @@ func SqlInstancePool(ctx context.Context, cred *azidentity.ClientSecretCredentia
 	return values, nil
 }
 
+// SqlInstancePoolByID describes the SQL instance pool with the ARM id.
+func SqlInstancePoolByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewInstancePoolsClient().Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSqlInstancePool(ctx, clientFactory, &resp.InstancePool)
+}
+
 func GetSqlInstancePool(ctx context.Context, clientFactory *armsql.ClientFactory, v *armsql.InstancePool) (*models.Resource, error) {
 	resourceGroupName := strings.Split(string(*v.ID), "/")[4]
 	resource := models.Resource{
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/mysql/armmysqlflexibleservers"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sqlvirtualmachine/armsqlvirtualmachine"
@@ -12,8 +13,53 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func SqlServer(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsql.NewClientFactory(subscription, cred, nil)
+// listSqlServers lists the SQL servers of the subscription, once per job.
+func listSqlServers(ctx context.Context, cred azcore.TokenCredential, subscription string) ([]*armsql.Server, error) {
+	return cachedParents(ctx, "sqlServers/"+subscription, func() ([]*armsql.Server, error) {
+		client, err := armsql.NewServersClient(subscription, cred, clientOptions(ctx))
+		if err != nil {
+			return nil, err
+		}
+		pager := client.NewListPager(nil)
+		var values []*armsql.Server
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, page.Value...)
+		}
+		return values, nil
+	})
+}
+
+func SqlServer(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	servers, err := listSqlServers(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
+	return describeSqlServers(ctx, cred, subscription, servers, stream)
+}
+
+// SqlServerByID describes the SQL server with the ARM id.
+func SqlServerByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsql.NewServersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	server, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return firstResource(describeSqlServers(ctx, cred, subscription, []*armsql.Server{&server.Server}, nil))
+}
+
+func describeSqlServers(ctx context.Context, cred azcore.TokenCredential, subscription string, servers []*armsql.Server, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -27,30 +73,10 @@ func SqlServer(ctx context.Context, cred *azidentity.ClientSecretCredential, sub
 	serverBlobClient := clientFactory.NewServerBlobAuditingPoliciesClient()
 	failoverClient := clientFactory.NewFailoverGroupsClient()
 	automaticTuningClient := clientFactory.NewServerAutomaticTuningClient()
-	client := clientFactory.NewServersClient()
 
-	pager := client.NewListPager(nil)
-	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, server := range page.Value {
-			resource, err := GetSqlServer(ctx, automaticTuningClient, failoverClient, virtualNetworkClient, privateEndpointClient, encryptionProtectorsClient, firewallRulesClient, serverVulnerabilityClient, serverAzureClient, serverSecurityClient, serverBlobClient, server)
-			if err != nil {
-				return nil, err
-			}
-			if stream != nil {
-				if err := (*stream)(*resource); err != nil {
-					return nil, err
-				}
-			} else {
-				values = append(values, *resource)
-			}
-		}
-	}
-	return values, err
+	return describeItems(ctx, servers, stream, func(ctx context.Context, server *armsql.Server) (*models.Resource, error) {
+		return GetSqlServer(ctx, automaticTuningClient, failoverClient, virtualNetworkClient, privateEndpointClient, encryptionProtectorsClient, firewallRulesClient, serverVulnerabilityClient, serverAzureClient, serverSecurityClient, serverBlobClient, server)
+	})
 }
 
 func GetSqlServer(ctx context.Context, automaticTuningClient *armsql.ServerAutomaticTuningClient, failoverClient *armsql.FailoverGroupsClient, virtualNetworkClient *armsql.VirtualNetworkRulesClient, privateEndpointClient *armsql.PrivateEndpointConnectionsClient, encryptionProtectorsClient *armsql.EncryptionProtectorsClient, firewallRulesClient *armsql.FirewallRulesClient, serverVulnerabilityClient *armsql.ServerVulnerabilityAssessmentsClient, serverAzureClient *armsql.ServerAzureADAdministratorsClient, serverSecurityClient *armsql.ServerSecurityAlertPoliciesClient, serverBlobClient *armsql.ServerBlobAuditingPoliciesClient, server *armsql.Server) (*models.Resource, error) {
@@ -175,41 +201,62 @@ func GetSqlServer(ctx context.Context, automaticTuningClient *armsql.ServerAutom
 	return &resource, nil
 }
 
-func SqlServerJobAgents(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsql.NewClientFactory(subscription, cred, nil)
+func SqlServerJobAgents(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	serverClient := clientFactory.NewServersClient()
 	client := clientFactory.NewJobAgentsClient()
 
 	var values []models.Resource
-	pager := serverClient.NewListPager(nil)
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
+	servers, err := listSqlServers(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
+	for _, server := range servers {
+		resources, err := ListSqlServerJobAgents(ctx, client, server)
 		if err != nil {
 			return nil, err
 		}
-		for _, server := range page.Value {
-			resources, err := ListSqlServerJobAgents(ctx, client, server)
-			if err != nil {
-				return nil, err
-			}
-			for _, resource := range resources {
-				if stream != nil {
-					if err := (*stream)(resource); err != nil {
-						return nil, err
-					}
-				} else {
-					values = append(values, resource)
+		for _, resource := range resources {
+			if stream != nil {
+				if err := (*stream)(resource); err != nil {
+					return nil, err
 				}
+			} else {
+				values = append(values, resource)
 			}
 		}
 	}
 	return values, err
 }
 
+// SqlServerJobAgentByID describes the SQL job agent with the ARM id.
+func SqlServerJobAgentByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsql.NewJobAgentsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armsql.NewServersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	server, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSqlServerJobAgent(ctx, &server.Server, &resp.JobAgent), nil
+}
+
 func ListSqlServerJobAgents(ctx context.Context, client *armsql.JobAgentsClient, server *armsql.Server) ([]models.Resource, error) {
 	resourceGroupName := strings.Split(string(*server.ID), "/")[4]
 
@@ -246,8 +293,8 @@ func GetSqlServerJobAgent(ctx context.Context, server *armsql.Server, job *armsq
 	return &resource
 }
 
-func SqlVirtualClusters(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsql.NewClientFactory(subscription, cred, nil)
+func SqlVirtualClusters(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -275,6 +322,23 @@ func SqlVirtualClusters(ctx context.Context, cred *azidentity.ClientSecretCreden
 	return values, nil
 }
 
+// SqlVirtualClusterByID describes the SQL virtual cluster with the ARM id.
+func SqlVirtualClusterByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsql.NewVirtualClustersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSqlVirtualCluster(ctx, &resp.VirtualCluster), nil
+}
+
 func GetSqlVirtualCluster(ctx context.Context, v *armsql.VirtualCluster) *models.Resource {
 	resourceGroupName := strings.Split(string(*v.ID), "/")[4]
 
@@ -293,42 +357,67 @@ func GetSqlVirtualCluster(ctx context.Context, v *armsql.VirtualCluster) *models
 	return &resource
 }
 
-func SqlServerElasticPool(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsql.NewClientFactory(subscription, cred, nil)
+func SqlServerElasticPool(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsql.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	client := clientFactory.NewServersClient()
 	elasticPoolClient := clientFactory.NewElasticPoolsClient()
 	activityClient := clientFactory.NewElasticPoolActivitiesClient()
 
 	var values []models.Resource
-	pager := client.NewListPager(nil)
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
+	servers, err := listSqlServers(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
+	for _, server := range servers {
+		resources, err := ListSqlServerElasticPools(ctx, elasticPoolClient, activityClient, server)
 		if err != nil {
 			return nil, err
 		}
-		for _, server := range page.Value {
-			resources, err := ListSqlServerElasticPools(ctx, elasticPoolClient, activityClient, server)
-			if err != nil {
-				return nil, err
-			}
-			for _, resource := range resources {
-				if stream != nil {
-					if err := (*stream)(resource); err != nil {
-						return nil, err
-					}
-				} else {
-					values = append(values, resource)
+		for _, resource := range resources {
+			if stream != nil {
+				if err := (*stream)(resource); err != nil {
+					return nil, err
 				}
+			} else {
+				values = append(values, resource)
 			}
 		}
 	}
 	return values, nil
 }
 
+// SqlServerElasticPoolByID describes the SQL elastic pool with the ARM id.
+func SqlServerElasticPoolByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsql.NewElasticPoolsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	activityClient, err := armsql.NewElasticPoolActivitiesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armsql.NewServersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	server, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSqlServerElasticPool(ctx, &server.Server, activityClient, &resp.ElasticPool)
+}
+
 func ListSqlServerElasticPools(ctx context.Context, elasticPoolClient *armsql.ElasticPoolsClient, activityClient *armsql.ElasticPoolActivitiesClient, server *armsql.Server) ([]models.Resource, error) {
 	if server == nil || server.ID == nil {
 		return nil, nil
@@ -404,8 +493,8 @@ func GetSqlServerElasticPool(ctx context.Context, server *armsql.Server, activit
 	return &resource, nil
 }
 
-func SqlServerVirtualMachine(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsqlvirtualmachine.NewClientFactory(subscription, cred, nil)
+func SqlServerVirtualMachine(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsqlvirtualmachine.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -432,6 +521,23 @@ func SqlServerVirtualMachine(ctx context.Context, cred *azidentity.ClientSecretC
 	return values, nil
 }
 
+// SqlServerVirtualMachineByID describes the SQL virtual machine with the ARM id.
+func SqlServerVirtualMachineByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsqlvirtualmachine.NewSQLVirtualMachinesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSqlServerVirtualMachine(ctx, &resp.SQLVirtualMachine), nil
+}
+
 func GetSqlServerVirtualMachine(ctx context.Context, vm *armsqlvirtualmachine.SQLVirtualMachine) *models.Resource {
 	resourceGroup := strings.Split(string(*vm.ID), "/")[4]
 	resource := models.Resource{
@@ -448,8 +554,8 @@ func GetSqlServerVirtualMachine(ctx context.Context, vm *armsqlvirtualmachine.SQ
 	return &resource
 }
 
-func SqlServerVirtualMachineGroups(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsqlvirtualmachine.NewClientFactory(subscription, cred, nil)
+func SqlServerVirtualMachineGroups(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsqlvirtualmachine.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -476,6 +582,23 @@ func SqlServerVirtualMachineGroups(ctx context.Context, cred *azidentity.ClientS
 	return values, nil
 }
 
+// SqlServerVirtualMachineGroupByID describes the SQL virtual machine group with the ARM id.
+func SqlServerVirtualMachineGroupByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsqlvirtualmachine.NewGroupsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSqlServerVirtualMachineGroups(ctx, &resp.Group), nil
+}
+
 func GetSqlServerVirtualMachineGroups(ctx context.Context, vm *armsqlvirtualmachine.Group) *models.Resource {
 	resourceGroup := strings.Split(string(*vm.ID), "/")[4]
 
@@ -493,8 +616,8 @@ func GetSqlServerVirtualMachineGroups(ctx context.Context, vm *armsqlvirtualmach
 	return &resource
 }
 
-func SqlServerFlexibleServer(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armmysqlflexibleservers.NewClientFactory(subscription, cred, nil)
+func SqlServerFlexibleServer(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armmysqlflexibleservers.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -521,6 +644,23 @@ func SqlServerFlexibleServer(ctx context.Context, cred *azidentity.ClientSecretC
 	return values, nil
 }
 
+// SqlServerFlexibleServerByID describes the flexible server with the ARM id.
+func SqlServerFlexibleServerByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armmysqlflexibleservers.NewServersClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSqlServerFlexibleServer(ctx, &resp.Server), nil
+}
+
 func GetSqlServerFlexibleServer(ctx context.Context, fs *armmysqlflexibleservers.Server) *models.Resource {
 	resourceGroup := strings.Split(string(*fs.ID), "/")[4]
 	resource := models.Resource{
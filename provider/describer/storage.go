@@ -6,7 +6,8 @@ import (
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/data/aztables"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
@@ -22,38 +23,58 @@ import (
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
 )
 
-func StorageContainer(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armstorage.NewClientFactory(subscription, cred, nil)
+// listStorageAccounts lists the storage accounts of the subscription, once per job.
+func listStorageAccounts(ctx context.Context, cred azcore.TokenCredential, subscription string) ([]*armstorage.Account, error) {
+	return cachedParents(ctx, "storageAccounts/"+subscription, func() ([]*armstorage.Account, error) {
+		client, err := armstorage.NewAccountsClient(subscription, cred, clientOptions(ctx))
+		if err != nil {
+			return nil, err
+		}
+		pager := client.NewListPager(nil)
+		var values []*armstorage.Account
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, page.Value...)
+		}
+		return values, nil
+	})
+}
+
+func StorageContainer(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	client := clientFactory.NewBlobContainersClient()
-	storageClient := clientFactory.NewAccountsClient()
 
 	wpe := concurrency.NewWorkPool(4)
 	var values []models.Resource
-	pager := storageClient.NewListPager(nil)
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, ac := range page.Value {
-			account := ac
-			wpe.AddJob(func() (interface{}, error) {
-				results, err := ListAccountStorageContainers(ctx, client, account)
-				if err != nil {
-					return nil, err
+	accounts, err := listStorageAccounts(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
+	for _, ac := range accounts {
+		account := ac
+		wpe.AddJob(func() (interface{}, error) {
+			results, err := ListAccountStorageContainers(ctx, client, account)
+			if err != nil {
+				// the containers of an account we can't read are skipped
+				if Tolerate(ctx, *account.ID, "BlobContainers.List", err) {
+					return nil, nil
 				}
-				return results, nil
-			})
-		}
+				return nil, err
+			}
+			return results, nil
+		})
 	}
 
 	results := wpe.Run()
 	for _, r := range results {
 		if r.Error != nil {
-			return nil, err
+			return nil, r.Error
 		}
 		if r.Value == nil {
 			continue
@@ -73,6 +94,49 @@ func StorageContainer(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// StorageContainerByID describes the blob container with the ARM id.
+func StorageContainerByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := storageAccountOf(ctx, clientFactory, id)
+	if err != nil {
+		return nil, err
+	}
+	client := clientFactory.NewBlobContainersClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, *account.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetAccountStorageContainter(ctx, client, &armstorage.ListContainerItem{
+		Properties: resp.ContainerProperties,
+		Etag:       resp.Etag,
+		ID:         resp.ID,
+		Name:       resp.Name,
+		Type:       resp.Type,
+	}, account)
+}
+
+// storageAccountOf gets the storage account the resource with the ARM id is
+// nested in.
+func storageAccountOf(ctx context.Context, clientFactory *armstorage.ClientFactory, id *arm.ResourceID) (*armstorage.Account, error) {
+	for parent := id.Parent; parent != nil; parent = parent.Parent {
+		if strings.EqualFold(parent.ResourceType.Type, "storageAccounts") {
+			resp, err := clientFactory.NewAccountsClient().GetProperties(ctx, parent.ResourceGroupName, parent.Name, nil)
+			if err != nil {
+				return nil, resourceNotFound(err)
+			}
+			return &resp.Account, nil
+		}
+	}
+	return nil, fmt.Errorf("%s is not in a storage account", id)
+}
+
 func ListAccountStorageContainers(ctx context.Context, client *armstorage.BlobContainersClient, account *armstorage.Account) ([]models.Resource, error) {
 	resourceGroup := &strings.Split(string(*account.ID), "/")[4]
 	var resources []models.Resource
@@ -117,16 +181,41 @@ func GetAccountStorageContainter(ctx context.Context, client *armstorage.BlobCon
 	}, nil
 }
 
-func StorageAccount(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+func StorageAccount(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	accounts, err := listStorageAccounts(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
+	return describeStorageAccounts(ctx, cred, subscription, accounts, stream)
+}
+
+// StorageAccountByID describes the storage account with the ARM id.
+func StorageAccountByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armstorage.NewAccountsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.GetProperties(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return firstResource(describeStorageAccounts(ctx, cred, subscription, []*armstorage.Account{&account.Account}, nil))
+}
+
+func describeStorageAccounts(ctx context.Context, cred azcore.TokenCredential, subscription string, accounts []*armstorage.Account, stream *models.StreamSender) ([]models.Resource, error) {
 
-	clientFactory, err := armstorage.NewClientFactory(subscription, cred, nil)
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
 	encryptionScopesStorageClient := clientFactory.NewEncryptionScopesClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -140,28 +229,9 @@ func StorageAccount(ctx context.Context, cred *azidentity.ClientSecretCredential
 
 	storageClient := clientFactory.NewAccountsClient()
 
-	pager := storageClient.NewListPager(nil)
-	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, account := range page.Value {
-			resource, err := GetStorageAccount(ctx, storageClient, encryptionScopesStorageClient, diagnosticClient, fileServicesStorageClient, blobServicesStorageClient, managementPoliciesStorageClient, account)
-			if err != nil {
-				return nil, err
-			}
-			if stream != nil {
-				if err := (*stream)(*resource); err != nil {
-					return nil, err
-				}
-			} else {
-				values = append(values, *resource)
-			}
-		}
-	}
-	return values, nil
+	return describeItems(ctx, accounts, stream, func(ctx context.Context, account *armstorage.Account) (*models.Resource, error) {
+		return GetStorageAccount(ctx, storageClient, encryptionScopesStorageClient, diagnosticClient, fileServicesStorageClient, blobServicesStorageClient, managementPoliciesStorageClient, account)
+	})
 }
 
 func GetStorageAccount(ctx context.Context, storageClient *armstorage.AccountsClient, encryptionScopesStorageClient *armstorage.EncryptionScopesClient, diagnosticClient *armmonitor.DiagnosticSettingsClient, fileServicesStorageClient *armstorage.FileServicesClient, blobServicesStorageClient *armstorage.BlobServicesClient, managementPoliciesStorageClient *armstorage.ManagementPoliciesClient, account *armstorage.Account) (*models.Resource, error) {
@@ -205,7 +275,7 @@ func GetStorageAccount(ctx context.Context, storageClient *armstorage.AccountsCl
 					return nil, err
 				}
 
-				client := accounts.New()
+				client := accounts.NewWithEnvironment(storageEnvironment(ctx))
 				client.Client.Authorizer = storageAuth
 
 				resp, err := client.GetServiceProperties(ctx, *account.Name)
@@ -266,7 +336,7 @@ func GetStorageAccount(ctx context.Context, storageClient *armstorage.AccountsCl
 					return nil, err
 				}
 
-				queuesClient := queues.New()
+				queuesClient := queues.NewWithEnvironment(storageEnvironment(ctx))
 				queuesClient.Client.Authorizer = storageAuth
 
 				resp, err := queuesClient.GetServiceProperties(ctx, *account.Name)
@@ -291,7 +361,7 @@ func GetStorageAccount(ctx context.Context, storageClient *armstorage.AccountsCl
 	if *account.Kind != "FileStorage" {
 
 		for _, key := range v.Keys {
-			serviceUrl := "https://" + *account.Name + ".table.core.windows.net/"
+			serviceUrl := storageURL(ctx, *account.Name, "table") + "/"
 
 			auth, err := aztables.NewSharedKeyCredential(*account.Name, *key.Value)
 			if err != nil {
@@ -351,34 +421,31 @@ func GetStorageAccount(ctx context.Context, storageClient *armstorage.AccountsCl
 	return &resource, nil
 }
 
-func StorageBlob(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armstorage.NewClientFactory(subscription, cred, nil)
+func StorageBlob(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	accountClient := clientFactory.NewAccountsClient()
 	containerClient := clientFactory.NewBlobContainersClient()
 
-	pager := accountClient.NewListPager(nil)
+	accounts, err := listStorageAccounts(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
 	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
+	for _, v := range accounts {
+		resources, err := ListAccountStorageBlobs(ctx, containerClient, accountClient, v)
 		if err != nil {
 			return nil, err
 		}
-		for _, v := range page.Value {
-			resources, err := ListAccountStorageBlobs(ctx, containerClient, accountClient, v)
-			if err != nil {
-				return nil, err
-			}
-			for _, resource := range resources {
-				if stream != nil {
-					if err := (*stream)(resource); err != nil {
-						return nil, err
-					}
-				} else {
-					values = append(values, resource)
+		for _, resource := range resources {
+			if stream != nil {
+				if err := (*stream)(resource); err != nil {
+					return nil, err
 				}
+			} else {
+				values = append(values, resource)
 			}
 		}
 	}
@@ -407,7 +474,7 @@ func ListAccountStorageBlobs(ctx context.Context, containerClient *armstorage.Bl
 	if err != nil {
 		return nil, err
 	}
-	baseUrl := fmt.Sprintf("https://%s.blob.core.windows.net", storageAccountName)
+	baseUrl := storageURL(ctx, storageAccountName, "blob")
 	blobClient, err := azblob.NewClientWithSharedKeyCredential(baseUrl, credential, nil)
 	if err != nil {
 		return nil, err
@@ -551,12 +618,11 @@ func ListAccountStorageBlobs(ctx context.Context, containerClient *armstorage.Bl
 	return values, nil
 }
 
-func StorageBlobService(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armstorage.NewClientFactory(subscription, cred, nil)
+func StorageBlobService(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	accountClient := clientFactory.NewAccountsClient()
 	storageClient := clientFactory.NewBlobServicesClient()
 
 	resourceGroups, err := listResourceGroups(ctx, cred, subscription)
@@ -564,39 +630,36 @@ func StorageBlobService(ctx context.Context, cred *azidentity.ClientSecretCreden
 		return nil, err
 	}
 
-	pager := accountClient.NewListPager(nil)
+	accounts, err := listStorageAccounts(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
 	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, account := range page.Value {
-			for _, resourceGroup := range resourceGroups {
-				var blobServices []*armstorage.BlobServiceProperties
-				pager := storageClient.NewListPager(*resourceGroup.Name, *account.Name, nil)
-				for pager.More() {
-					page, err := pager.NextPage(ctx)
-					if err != nil {
-						if strings.Contains(err.Error(), "ParentResourceNotFound") ||
-							strings.Contains(err.Error(), "ContainerOperationFailure") ||
-							strings.Contains(err.Error(), "FeatureNotSupportedForAccount") {
-							continue
-						}
-						return nil, err
+	for _, account := range accounts {
+		for _, resourceGroup := range resourceGroups {
+			var blobServices []*armstorage.BlobServiceProperties
+			pager := storageClient.NewListPager(*resourceGroup.Name, *account.Name, nil)
+			for pager.More() {
+				page, err := pager.NextPage(ctx)
+				if err != nil {
+					if strings.Contains(err.Error(), "ParentResourceNotFound") ||
+						strings.Contains(err.Error(), "ContainerOperationFailure") ||
+						strings.Contains(err.Error(), "FeatureNotSupportedForAccount") {
+						continue
 					}
-					blobServices = append(blobServices, page.Value...)
+					return nil, err
 				}
+				blobServices = append(blobServices, page.Value...)
+			}
 
-				for _, blobService := range blobServices {
-					resource := GetStorageBlobService(ctx, account, resourceGroup, blobService)
-					if stream != nil {
-						if err := (*stream)(*resource); err != nil {
-							return nil, err
-						}
-					} else {
-						values = append(values, *resource)
+			for _, blobService := range blobServices {
+				resource := GetStorageBlobService(ctx, account, resourceGroup, blobService)
+				if stream != nil {
+					if err := (*stream)(*resource); err != nil {
+						return nil, err
 					}
+				} else {
+					values = append(values, *resource)
 				}
 			}
 		}
@@ -604,6 +667,27 @@ func StorageBlobService(ctx context.Context, cred *azidentity.ClientSecretCreden
 	return values, nil
 }
 
+// StorageBlobServiceByID describes the blob service with the ARM id.
+func StorageBlobServiceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := storageAccountOf(ctx, clientFactory, id)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewBlobServicesClient().GetServiceProperties(ctx, id.ResourceGroupName, *account.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetStorageBlobService(ctx, account, armresources.ResourceGroup{Name: &id.ResourceGroupName}, &resp.BlobServiceProperties), nil
+}
+
 func GetStorageBlobService(ctx context.Context, account *armstorage.Account, resourceGroup armresources.ResourceGroup, blobService *armstorage.BlobServiceProperties) *models.Resource {
 	resource := models.Resource{
 		ID:       *blobService.ID,
@@ -621,12 +705,11 @@ func GetStorageBlobService(ctx context.Context, account *armstorage.Account, res
 	return &resource
 }
 
-func StorageQueue(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armstorage.NewClientFactory(subscription, cred, nil)
+func StorageQueue(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	accountClient := clientFactory.NewAccountsClient()
 	storageClient := clientFactory.NewQueueClient()
 
 	resourceGroups, err := listResourceGroups(ctx, cred, subscription)
@@ -634,27 +717,24 @@ func StorageQueue(ctx context.Context, cred *azidentity.ClientSecretCredential,
 		return nil, err
 	}
 
-	pager := accountClient.NewListPager(nil)
+	accounts, err := listStorageAccounts(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
 	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, account := range page.Value {
-			for _, resourceGroup := range resourceGroups {
-				resources, err := ListAccountStorageQueue(ctx, storageClient, account, resourceGroup)
-				if err != nil {
-					return nil, err
-				}
-				for _, resource := range resources {
-					if stream != nil {
-						if err := (*stream)(resource); err != nil {
-							return nil, err
-						}
-					} else {
-						values = append(values, resource)
+	for _, account := range accounts {
+		for _, resourceGroup := range resourceGroups {
+			resources, err := ListAccountStorageQueue(ctx, storageClient, account, resourceGroup)
+			if err != nil {
+				return nil, err
+			}
+			for _, resource := range resources {
+				if stream != nil {
+					if err := (*stream)(resource); err != nil {
+						return nil, err
 					}
+				} else {
+					values = append(values, resource)
 				}
 			}
 		}
@@ -662,6 +742,31 @@ func StorageQueue(ctx context.Context, cred *azidentity.ClientSecretCredential,
 	return values, nil
 }
 
+// StorageQueueByID describes the queue with the ARM id.
+func StorageQueueByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := storageAccountOf(ctx, clientFactory, id)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewQueueClient().Get(ctx, id.ResourceGroupName, *account.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	queue := armstorage.ListQueue{ID: resp.ID, Name: resp.Name, Type: resp.Type}
+	if resp.QueueProperties != nil {
+		queue.QueueProperties = &armstorage.ListQueueProperties{Metadata: resp.QueueProperties.Metadata}
+	}
+	return GetStorageQueue(ctx, account, armresources.ResourceGroup{Name: &id.ResourceGroupName}, &queue), nil
+}
+
 func ListAccountStorageQueue(ctx context.Context, storageClient *armstorage.QueueClient, account *armstorage.Account, resourceGroup armresources.ResourceGroup) ([]models.Resource, error) {
 	var values []models.Resource
 	pager := storageClient.NewListPager(*resourceGroup.Name, *account.Name, nil)
@@ -707,12 +812,11 @@ func GetStorageQueue(ctx context.Context, account *armstorage.Account, resourceG
 	return &resource
 }
 
-func StorageFileShare(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armstorage.NewClientFactory(subscription, cred, nil)
+func StorageFileShare(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	accountClient := clientFactory.NewAccountsClient()
 	storageClient := clientFactory.NewFileSharesClient()
 
 	resourceGroups, err := listResourceGroups(ctx, cred, subscription)
@@ -720,27 +824,24 @@ func StorageFileShare(ctx context.Context, cred *azidentity.ClientSecretCredenti
 		return nil, err
 	}
 
-	pager := accountClient.NewListPager(nil)
+	accounts, err := listStorageAccounts(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
 	var values []models.Resource
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, account := range page.Value {
-			for _, resourceGroup := range resourceGroups {
-				resources, err := ListAccountStorageFileShares(ctx, storageClient, account, resourceGroup)
-				if err != nil {
-					return nil, err
-				}
-				for _, resource := range resources {
-					if stream != nil {
-						if err := (*stream)(resource); err != nil {
-							return nil, err
-						}
-					} else {
-						values = append(values, resource)
+	for _, account := range accounts {
+		for _, resourceGroup := range resourceGroups {
+			resources, err := ListAccountStorageFileShares(ctx, storageClient, account, resourceGroup)
+			if err != nil {
+				return nil, err
+			}
+			for _, resource := range resources {
+				if stream != nil {
+					if err := (*stream)(resource); err != nil {
+						return nil, err
 					}
+				} else {
+					values = append(values, resource)
 				}
 			}
 		}
@@ -748,6 +849,33 @@ func StorageFileShare(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// StorageFileShareByID describes the file share with the ARM id.
+func StorageFileShareByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := storageAccountOf(ctx, clientFactory, id)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewFileSharesClient().Get(ctx, id.ResourceGroupName, *account.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetStorageFileShares(ctx, account, armresources.ResourceGroup{Name: &id.ResourceGroupName}, &armstorage.FileShareItem{
+		Properties: resp.FileShareProperties,
+		Etag:       resp.Etag,
+		ID:         resp.ID,
+		Name:       resp.Name,
+		Type:       resp.Type,
+	}), nil
+}
+
 func ListAccountStorageFileShares(ctx context.Context, storageClient *armstorage.FileSharesClient, account *armstorage.Account, resourceGroup armresources.ResourceGroup) ([]models.Resource, error) {
 	pager := storageClient.NewListPager(*resourceGroup.Name, *account.Name, nil)
 	var values []models.Resource
@@ -786,12 +914,11 @@ func GetStorageFileShares(ctx context.Context, account *armstorage.Account, reso
 	return &resource
 }
 
-func StorageTable(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armstorage.NewClientFactory(subscription, cred, nil)
+func StorageTable(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	accountClient := clientFactory.NewAccountsClient()
 	storageClient := clientFactory.NewTableClient()
 
 	resourceGroups, err := listResourceGroups(ctx, cred, subscription)
@@ -800,29 +927,26 @@ func StorageTable(ctx context.Context, cred *azidentity.ClientSecretCredential,
 	}
 
 	var values []models.Resource
-	pager := accountClient.NewListPager(nil)
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
+	accounts, err := listStorageAccounts(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if *account.Kind == "FileStorage" || *account.Kind == "BlockBlobStorage" {
+			continue
 		}
-		for _, account := range page.Value {
-			if *account.Kind == "FileStorage" || *account.Kind == "BlockBlobStorage" {
-				continue
+		for _, resourceGroup := range resourceGroups {
+			resources, err := ListAccountStorageTables(ctx, storageClient, account, resourceGroup)
+			if err != nil {
+				return nil, err
 			}
-			for _, resourceGroup := range resourceGroups {
-				resources, err := ListAccountStorageTables(ctx, storageClient, account, resourceGroup)
-				if err != nil {
-					return nil, err
-				}
-				for _, resource := range resources {
-					if stream != nil {
-						if err := (*stream)(resource); err != nil {
-							return nil, err
-						}
-					} else {
-						values = append(values, resource)
+			for _, resource := range resources {
+				if stream != nil {
+					if err := (*stream)(resource); err != nil {
+						return nil, err
 					}
+				} else {
+					values = append(values, resource)
 				}
 			}
 		}
@@ -830,6 +954,27 @@ func StorageTable(ctx context.Context, cred *azidentity.ClientSecretCredential,
 	return values, nil
 }
 
+// StorageTableByID describes the table with the ARM id.
+func StorageTableByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := storageAccountOf(ctx, clientFactory, id)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewTableClient().Get(ctx, id.ResourceGroupName, *account.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetStorageTable(ctx, account, armresources.ResourceGroup{Name: &id.ResourceGroupName}, &resp.Table), nil
+}
+
 func ListAccountStorageTables(ctx context.Context, storageClient *armstorage.TableClient, account *armstorage.Account, resourceGroup armresources.ResourceGroup) ([]models.Resource, error) {
 	pager := storageClient.NewListPager(*resourceGroup.Name, *account.Name, nil)
 	var values []models.Resource
@@ -874,12 +1019,11 @@ func GetStorageTable(ctx context.Context, account *armstorage.Account, resourceG
 	return &resource
 }
 
-func StorageTableService(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armstorage.NewClientFactory(subscription, cred, nil)
+func StorageTableService(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	accountClient := clientFactory.NewAccountsClient()
 	storageClient := clientFactory.NewTableServicesClient()
 
 	resourceGroups, err := listResourceGroups(ctx, cred, subscription)
@@ -888,32 +1032,29 @@ func StorageTableService(ctx context.Context, cred *azidentity.ClientSecretCrede
 	}
 
 	var values []models.Resource
-	pager := accountClient.NewListPager(nil)
-	for pager.More() {
-		page, err := pager.NextPage(ctx)
-		if err != nil {
-			return nil, err
+	accounts, err := listStorageAccounts(ctx, cred, subscription)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if *account.Kind == "FileStorage" {
+			continue
 		}
-		for _, account := range page.Value {
-			if *account.Kind == "FileStorage" {
+		for _, resourceGroup := range resourceGroups {
+			resources, err := ListAccountStorageTableService(ctx, storageClient, account, resourceGroup)
+			if err != nil {
+				return nil, err
+			}
+			if resources == nil {
 				continue
 			}
-			for _, resourceGroup := range resourceGroups {
-				resources, err := ListAccountStorageTableService(ctx, storageClient, account, resourceGroup)
-				if err != nil {
-					return nil, err
-				}
-				if resources == nil {
-					continue
-				}
-				for _, resource := range resources {
-					if stream != nil {
-						if err := (*stream)(resource); err != nil {
-							return nil, err
-						}
-					} else {
-						values = append(values, resource)
+			for _, resource := range resources {
+				if stream != nil {
+					if err := (*stream)(resource); err != nil {
+						return nil, err
 					}
+				} else {
+					values = append(values, resource)
 				}
 			}
 		}
@@ -921,6 +1062,27 @@ func StorageTableService(ctx context.Context, cred *azidentity.ClientSecretCrede
 	return values, nil
 }
 
+// StorageTableServiceByID describes the table service with the ARM id.
+func StorageTableServiceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	clientFactory, err := armstorage.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	account, err := storageAccountOf(ctx, clientFactory, id)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clientFactory.NewTableServicesClient().GetServiceProperties(ctx, id.ResourceGroupName, *account.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetAccountStorageTableService(ctx, armresources.ResourceGroup{Name: &id.ResourceGroupName}, account, &resp.TableServiceProperties), nil
+}
+
 func ListAccountStorageTableService(ctx context.Context, storageClient *armstorage.TableServicesClient, account *armstorage.Account, resourceGroup armresources.ResourceGroup) ([]models.Resource, error) {
 	tableServices, err := storageClient.List(ctx, *resourceGroup.Name, *account.Name, nil)
 	if err != nil {
@@ -2,15 +2,16 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/subscription/armsubscription"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func Tenant(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsubscription.NewClientFactory(cred, nil)
+func Tenant(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsubscription.NewClientFactory(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -54,12 +55,12 @@ func GetTenand(ctx context.Context, v *armsubscription.TenantIDDescription) *mod
 	return &resource
 }
 
-func Subscription(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsubscription.NewClientFactory(cred, nil)
+func Subscription(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsubscription.NewClientFactory(cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	resourceClientFactory, err := armresources.NewClientFactory(subscription, cred, nil)
+	resourceClientFactory, err := armresources.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -118,3 +119,12 @@ func Subscription(ctx context.Context, cred *azidentity.ClientSecretCredential,
 
 	return values, nil
 }
+
+// SubscriptionByID describes the subscription with the ARM id.
+func SubscriptionByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	return firstResource(Subscription(ctx, cred, id.SubscriptionID, nil))
+}
@@ -2,7 +2,8 @@ package describer
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/synapse/armsynapse"
 	"github.com/opengovern/og-describer-azure/pkg/sdk/models"
@@ -11,15 +12,15 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 )
 
-func SynapseWorkspace(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsynapse.NewClientFactory(subscription, cred, nil)
+func SynapseWorkspace(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsynapse.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 	synapseClient := clientFactory.NewWorkspaceManagedSQLServerVulnerabilityAssessmentsClient()
 	client := clientFactory.NewWorkspacesClient()
 
-	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, nil)
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +50,32 @@ func SynapseWorkspace(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// SynapseWorkspaceByID describes the Synapse workspace with the ARM id.
+func SynapseWorkspaceByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsynapse.NewWorkspacesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	synapseClient, err := armsynapse.NewWorkspaceManagedSQLServerVulnerabilityAssessmentsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	monitorClientFactory, err := armmonitor.NewClientFactory(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	diagnosticClient := monitorClientFactory.NewDiagnosticSettingsClient()
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSynapseWorkspace(ctx, synapseClient, diagnosticClient, &resp.Workspace)
+}
+
 func GetSynapseWorkspace(ctx context.Context, synapseClient *armsynapse.WorkspaceManagedSQLServerVulnerabilityAssessmentsClient, diagnosticClient *armmonitor.DiagnosticSettingsClient, config *armsynapse.Workspace) (*models.Resource, error) {
 	resourceGroup := strings.Split(*config.ID, "/")[4]
 
@@ -77,6 +104,9 @@ func GetSynapseWorkspace(ctx context.Context, synapseClient *armsynapse.Workspac
 	for pager2.More() {
 		page2, err := pager2.NextPage(ctx)
 		if err != nil {
+			if Tolerate(ctx, *config.ID, "DiagnosticSettings.List", err) {
+				break
+			}
 			return nil, err
 		}
 		synapseListOp = append(synapseListOp, page2.Value...)
@@ -98,8 +128,8 @@ func GetSynapseWorkspace(ctx context.Context, synapseClient *armsynapse.Workspac
 	return &resource, nil
 }
 
-func SynapseWorkspaceBigdataPools(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsynapse.NewClientFactory(subscription, cred, nil)
+func SynapseWorkspaceBigdataPools(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsynapse.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +154,31 @@ func SynapseWorkspaceBigdataPools(ctx context.Context, cred *azidentity.ClientSe
 	return values, err
 }
 
+// SynapseWorkspaceBigdataPoolByID describes the Synapse big data pool with the ARM id.
+func SynapseWorkspaceBigdataPoolByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsynapse.NewBigDataPoolsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armsynapse.NewWorkspacesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	workspace, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSynapseWorkspaceBigdataPools(ctx, id.ResourceGroupName, &resp.BigDataPoolResourceInfo, &workspace.Workspace), nil
+}
+
 func ListSynapseWorkspaceBigdataPools(ctx context.Context, bigDataPoolsClient *armsynapse.BigDataPoolsClient, v *armsynapse.Workspace) ([]models.Resource, error) {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -158,8 +213,8 @@ func GetSynapseWorkspaceBigdataPools(ctx context.Context, resourceGroup string,
 	return &resource
 }
 
-func SynapseWorkspaceSqlpools(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	clientFactory, err := armsynapse.NewClientFactory(subscription, cred, nil)
+func SynapseWorkspaceSqlpools(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	clientFactory, err := armsynapse.NewClientFactory(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +247,31 @@ func SynapseWorkspaceSqlpools(ctx context.Context, cred *azidentity.ClientSecret
 	return values, nil
 }
 
+// SynapseWorkspaceSqlpoolByID describes the Synapse SQL pool with the ARM id.
+func SynapseWorkspaceSqlpoolByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := armsynapse.NewSQLPoolsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := armsynapse.NewWorkspacesClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	workspace, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetSynapseWorkspaceSqlpools(ctx, &workspace.Workspace, &resp.SQLPool), nil
+}
+
 func ListSynapseWorkspaceSqlpools(ctx context.Context, bpClient *armsynapse.SQLPoolsClient, v *armsynapse.Workspace) ([]models.Resource, error) {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
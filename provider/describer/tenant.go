@@ -0,0 +1,54 @@
+package describer
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/subscription/armsubscription"
+)
+
+var (
+	tenantScopeKey string = "tenant_scope"
+)
+
+// WithTenantScope makes a job describe every subscription the credential can
+// read instead of the subscription of the job, at most concurrency of them at
+// a time.
+func WithTenantScope(ctx context.Context, concurrency int) context.Context {
+	return context.WithValue(ctx, tenantScopeKey, concurrency)
+}
+
+// GetTenantScopeFromContext returns the subscription concurrency of a tenant
+// scoped job, and false for a subscription scoped one.
+func GetTenantScopeFromContext(ctx context.Context) (int, bool) {
+	concurrency, ok := ctx.Value(tenantScopeKey).(int)
+	return concurrency, ok
+}
+
+// ListSubscriptionIDs lists the subscriptions of the tenant that can be
+// described, disabled and deleted ones are left out.
+func ListSubscriptionIDs(ctx context.Context, cred azcore.TokenCredential) ([]string, error) {
+	client, err := armsubscription.NewSubscriptionsClient(cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	pager := client.NewListPager(nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, sub := range page.Value {
+			if sub.SubscriptionID == nil {
+				continue
+			}
+			if sub.State != nil && (*sub.State == armsubscription.SubscriptionStateDisabled || *sub.State == armsubscription.SubscriptionStateDeleted) {
+				continue
+			}
+			ids = append(ids, *sub.SubscriptionID)
+		}
+	}
+	return ids, nil
+}
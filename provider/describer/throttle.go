@@ -0,0 +1,208 @@
+package describer
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/opengovern/og-describer-azure/pkg/metrics"
+	"golang.org/x/time/rate"
+)
+
+// governorConfig paces the requests of the whole process. The defaults stay
+// well below the ARM limits of a subscription and of a tenant.
+type governorConfig struct {
+	SubscriptionRate  float64
+	SubscriptionBurst int
+	TenantRate        float64
+	TenantBurst       int
+	// LowWater is the remaining reads under which a key is slowed down to
+	// SlowRate, before ARM starts answering with 429.
+	LowWater int
+	SlowRate float64
+}
+
+func governorConfigFromEnv() governorConfig {
+	return governorConfig{
+		SubscriptionRate:  envFloat("ARM_SUBSCRIPTION_RATE", 20),
+		SubscriptionBurst: int(envFloat("ARM_SUBSCRIPTION_BURST", 100)),
+		TenantRate:        envFloat("ARM_TENANT_RATE", 50),
+		TenantBurst:       int(envFloat("ARM_TENANT_BURST", 250)),
+		LowWater:          int(envFloat("ARM_RATELIMIT_LOW_WATER", 100)),
+		SlowRate:          envFloat("ARM_RATELIMIT_SLOW_RATE", 1),
+	}
+}
+
+func envFloat(key string, def float64) float64 {
+	v, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil || v <= 0 {
+		return def
+	}
+	return v
+}
+
+// bucket is the pacing state of a subscription or a tenant.
+type bucket struct {
+	limiter     *rate.Limiter
+	rate        rate.Limit
+	slow        bool
+	pausedUntil time.Time
+}
+
+type governor struct {
+	cfg governorConfig
+
+	lock    sync.Mutex
+	buckets map[string]*bucket
+}
+
+var armGovernor = &governor{cfg: governorConfigFromEnv(), buckets: map[string]*bucket{}}
+
+func (g *governor) bucket(key string, r float64, burst int) *bucket {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	b, ok := g.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(r), burst), rate: rate.Limit(r)}
+		g.buckets[key] = b
+	}
+	return b
+}
+
+// requestBuckets are the buckets a request counts against, its subscription
+// if it has one and the tenant of the job.
+type requestBuckets struct {
+	subscription *bucket
+	tenant       *bucket
+}
+
+func (r requestBuckets) all() []*bucket {
+	if r.subscription == nil {
+		return []*bucket{r.tenant}
+	}
+	return []*bucket{r.subscription, r.tenant}
+}
+
+func (g *governor) bucketsOf(ctx context.Context, path string) requestBuckets {
+	var r requestBuckets
+	if sub := subscriptionOf(path); sub != "" {
+		r.subscription = g.bucket("subscription/"+sub, g.cfg.SubscriptionRate, g.cfg.SubscriptionBurst)
+	}
+	r.tenant = g.bucket("tenant/"+GetTenantIDFromContext(ctx), g.cfg.TenantRate, g.cfg.TenantBurst)
+	return r
+}
+
+// wait blocks until every bucket lets the request through.
+func (g *governor) wait(ctx context.Context, buckets requestBuckets) error {
+	for _, b := range buckets.all() {
+		g.lock.Lock()
+		pause := time.Until(b.pausedUntil)
+		g.lock.Unlock()
+		if pause > 0 {
+			timer := time.NewTimer(pause)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+		if err := b.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// observe adjusts the buckets to what ARM reports is left of its limits.
+func (g *governor) observe(buckets requestBuckets, resp *http.Response) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if after, ok := retryAfter(resp); ok {
+		for _, b := range buckets.all() {
+			if until := time.Now().Add(after); until.After(b.pausedUntil) {
+				b.pausedUntil = until
+			}
+		}
+	}
+
+	for header, b := range map[string]*bucket{
+		"x-ms-ratelimit-remaining-subscription-reads": buckets.subscription,
+		"x-ms-ratelimit-remaining-tenant-reads":       buckets.tenant,
+	} {
+		remaining, err := strconv.Atoi(resp.Header.Get(header))
+		if b == nil || err != nil {
+			continue
+		}
+		switch {
+		case remaining < g.cfg.LowWater && !b.slow:
+			b.slow = true
+			b.limiter.SetLimit(rate.Limit(g.cfg.SlowRate))
+		case remaining >= 2*g.cfg.LowWater && b.slow:
+			b.slow = false
+			b.limiter.SetLimit(b.rate)
+		}
+	}
+}
+
+// retryAfter reads how long ARM asks to wait, from Retry-After on a 429 or
+// from the resource graph quota headers once the quota is used up.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if t, err := http.ParseTime(resp.Header.Get("Retry-After")); err == nil {
+			return time.Until(t), true
+		}
+		return 10 * time.Second, true
+	}
+	if resp.Header.Get("x-ms-user-quota-remaining") != "" {
+		remaining, after, err := quota(resp.Header)
+		if err == nil && remaining == 0 {
+			return after, true
+		}
+	}
+	return 0, false
+}
+
+// subscriptionOf returns the subscription of an ARM path, empty for tenant
+// level paths.
+func subscriptionOf(path string) string {
+	parts := strings.Split(strings.ToLower(path), "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "subscriptions" {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// throttlePolicy paces the requests of every job of the process so they stay
+// under the ARM limits, shared across all the clients.
+type throttlePolicy struct{}
+
+func (throttlePolicy) Do(req *policy.Request) (*http.Response, error) {
+	raw := req.Raw()
+	buckets := armGovernor.bucketsOf(raw.Context(), raw.URL.Path)
+	start := time.Now()
+	if err := armGovernor.wait(raw.Context(), buckets); err != nil {
+		return nil, err
+	}
+	if waited := time.Since(start); waited > 10*time.Millisecond {
+		metrics.AzureAPIThrottleWait.WithLabelValues(resourceProviderOf(raw.URL.Path)).Add(waited.Seconds())
+	}
+
+	resp, err := req.Next()
+	if err != nil {
+		return resp, err
+	}
+	armGovernor.observe(buckets, resp)
+	return resp, nil
+}
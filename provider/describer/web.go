@@ -7,12 +7,13 @@ import (
 	"github.com/opengovern/og-describer-azure/provider/model"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	appservice "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice"
 )
 
-func AppServiceEnvironment(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := appservice.NewEnvironmentsClient(subscription, cred, nil)
+func AppServiceEnvironment(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := appservice.NewEnvironmentsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +39,23 @@ func AppServiceEnvironment(ctx context.Context, cred *azidentity.ClientSecretCre
 	return values, nil
 }
 
+// AppServiceEnvironmentByID describes the App Service environment with the ARM id.
+func AppServiceEnvironmentByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := appservice.NewEnvironmentsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetAppServiceEnvironment(ctx, &resp.EnvironmentResource), nil
+}
+
 func GetAppServiceEnvironment(ctx context.Context, v *appservice.EnvironmentResource) *models.Resource {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -56,13 +74,13 @@ func GetAppServiceEnvironment(ctx context.Context, v *appservice.EnvironmentReso
 	return &resource
 }
 
-func AppServiceFunctionApp(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := appservice.NewWebAppsClient(subscription, cred, nil)
+func AppServiceFunctionApp(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := appservice.NewWebAppsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	webClient, err := appservice.NewWebAppsClient(subscription, cred, nil)
+	webClient, err := appservice.NewWebAppsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -74,23 +92,34 @@ func AppServiceFunctionApp(ctx context.Context, cred *azidentity.ClientSecretCre
 		if err != nil {
 			return nil, err
 		}
-		for _, v := range page.Value {
-			resource, err := GetAppServiceFunctionApp(ctx, webClient, v)
-			if err != nil {
-				return nil, err
-			}
-			if stream != nil {
-				if err := (*stream)(*resource); err != nil {
-					return nil, err
-				}
-			} else {
-				values = append(values, *resource)
-			}
+		resources, err := describeItems(ctx, page.Value, stream, func(ctx context.Context, v *appservice.Site) (*models.Resource, error) {
+			return GetAppServiceFunctionApp(ctx, webClient, v)
+		})
+		if err != nil {
+			return nil, err
 		}
+		values = append(values, resources...)
 	}
 	return values, err
 }
 
+// AppServiceFunctionAppByID describes the function app with the ARM id.
+func AppServiceFunctionAppByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := appservice.NewWebAppsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetAppServiceFunctionApp(ctx, client, &resp.Site)
+}
+
 func GetAppServiceFunctionApp(ctx context.Context, webClient *appservice.WebAppsClient, v *appservice.Site) (*models.Resource, error) {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -118,12 +147,12 @@ func GetAppServiceFunctionApp(ctx context.Context, webClient *appservice.WebApps
 	return &resource, nil
 }
 
-func AppServiceWebApp(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := appservice.NewWebAppsClient(subscription, cred, nil)
+func AppServiceWebApp(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := appservice.NewWebAppsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
-	webClient, err := appservice.NewWebAppsClient(subscription, cred, nil)
+	webClient, err := appservice.NewWebAppsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -135,26 +164,34 @@ func AppServiceWebApp(ctx context.Context, cred *azidentity.ClientSecretCredenti
 		if err != nil {
 			return nil, err
 		}
-		for _, v := range page.Value {
-			resource, err := GetAppServiceWebApp(ctx, webClient, v)
-			if err != nil {
-				return nil, err
-			}
-			if resource == nil {
-				continue
-			}
-			if stream != nil {
-				if err := (*stream)(*resource); err != nil {
-					return nil, err
-				}
-			} else {
-				values = append(values, *resource)
-			}
+		resources, err := describeItems(ctx, page.Value, stream, func(ctx context.Context, v *appservice.Site) (*models.Resource, error) {
+			return GetAppServiceWebApp(ctx, webClient, v)
+		})
+		if err != nil {
+			return nil, err
 		}
+		values = append(values, resources...)
 	}
 	return values, err
 }
 
+// AppServiceWebAppByID describes the web app with the ARM id.
+func AppServiceWebAppByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := appservice.NewWebAppsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	site, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetAppServiceWebApp(ctx, client, &site.Site)
+}
+
 func GetAppServiceWebApp(ctx context.Context, webClient *appservice.WebAppsClient, v *appservice.Site) (*models.Resource, error) {
 	var err error
 
@@ -223,8 +260,8 @@ func GetAppServiceWebApp(ctx context.Context, webClient *appservice.WebAppsClien
 	return &resource, nil
 }
 
-func AppServiceWebAppSlot(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := appservice.NewWebAppsClient(subscription, cred, nil)
+func AppServiceWebAppSlot(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := appservice.NewWebAppsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -236,25 +273,42 @@ func AppServiceWebAppSlot(ctx context.Context, cred *azidentity.ClientSecretCred
 		if err != nil {
 			return nil, err
 		}
-		for _, v := range page.Value {
-			resources, err := ListAppServiceWebAppSlots(ctx, client, v)
-			if err != nil {
-				return nil, err
-			}
-			if stream != nil {
-				for _, resource := range resources {
-					if err := (*stream)(resource); err != nil {
-						return nil, err
-					}
-				}
-			} else {
-				values = append(values, resources...)
-			}
+		resources, err := describeItemsMulti(ctx, page.Value, stream, func(ctx context.Context, v *appservice.Site) ([]models.Resource, error) {
+			return ListAppServiceWebAppSlots(ctx, client, v)
+		})
+		if err != nil {
+			return nil, err
 		}
+		values = append(values, resources...)
 	}
 	return values, err
 }
 
+// AppServiceWebAppSlotByID describes the web app slot with the ARM id.
+func AppServiceWebAppSlotByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := appservice.NewWebAppsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetSlot(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	parentClient, err := appservice.NewWebAppsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	app, err := parentClient.Get(ctx, id.ResourceGroupName, id.Parent.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetAppServiceWebAppSlot(ctx, &app.Site, &resp.Site), nil
+}
+
 func ListAppServiceWebAppSlots(ctx context.Context, webClient *appservice.WebAppsClient, v *appservice.Site) ([]models.Resource, error) {
 	pager := webClient.NewListSlotsPager(*v.Properties.ResourceGroup, *v.Name, nil)
 	var values []models.Resource
@@ -290,8 +344,8 @@ func GetAppServiceWebAppSlot(ctx context.Context, app *appservice.Site, v *appse
 	return &resource
 }
 
-func AppServicePlan(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := appservice.NewPlansClient(subscription, cred, nil)
+func AppServicePlan(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := appservice.NewPlansClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -320,6 +374,23 @@ func AppServicePlan(ctx context.Context, cred *azidentity.ClientSecretCredential
 	return values, nil
 }
 
+// AppServicePlanByID describes the App Service plan with the ARM id.
+func AppServicePlanByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := appservice.NewPlansClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetAppServicePlan(ctx, client, &resp.Plan)
+}
+
 func GetAppServicePlan(ctx context.Context, client *appservice.PlansClient, v *appservice.Plan) (*models.Resource, error) {
 	resourceGroup := strings.Split(*v.ID, "/")[4]
 
@@ -355,8 +426,8 @@ func GetAppServicePlan(ctx context.Context, client *appservice.PlansClient, v *a
 	return &resource, nil
 }
 
-func AppContainerApps(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := appservice.NewContainerAppsClient(subscription, cred, nil)
+func AppContainerApps(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := appservice.NewContainerAppsClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -382,6 +453,23 @@ func AppContainerApps(ctx context.Context, cred *azidentity.ClientSecretCredenti
 	return values, nil
 }
 
+// AppContainerAppByID describes the container app with the ARM id.
+func AppContainerAppByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := appservice.NewContainerAppsClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetAppContainerApps(ctx, &resp.ContainerApp), nil
+}
+
 func GetAppContainerApps(ctx context.Context, server *appservice.ContainerApp) *models.Resource {
 	resourceGroupName := strings.Split(string(*server.ID), "/")[4]
 
@@ -400,8 +488,8 @@ func GetAppContainerApps(ctx context.Context, server *appservice.ContainerApp) *
 	return &resource
 }
 
-func WebServerFarms(ctx context.Context, cred *azidentity.ClientSecretCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
-	client, err := appservice.NewPlansClient(subscription, cred, nil)
+func WebServerFarms(ctx context.Context, cred azcore.TokenCredential, subscription string, stream *models.StreamSender) ([]models.Resource, error) {
+	client, err := appservice.NewPlansClient(subscription, cred, clientOptions(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -427,6 +515,23 @@ func WebServerFarms(ctx context.Context, cred *azidentity.ClientSecretCredential
 	return values, nil
 }
 
+// WebServerFarmByID describes the server farm with the ARM id.
+func WebServerFarmByID(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*models.Resource, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := appservice.NewPlansClient(subscription, cred, clientOptions(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return nil, resourceNotFound(err)
+	}
+	return GetWebServerFarm(ctx, &resp.Plan), nil
+}
+
 func GetWebServerFarm(ctx context.Context, v *appservice.Plan) *models.Resource {
 	resourceGroupName := strings.Split(string(*v.ID), "/")[4]
 
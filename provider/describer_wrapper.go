@@ -2,35 +2,306 @@ package provider
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	model "github.com/opengovern/og-describer-azure/pkg/sdk/models"
 	"github.com/opengovern/og-describer-azure/provider/configs"
+	"github.com/opengovern/og-util/pkg/concurrency"
 
 	"github.com/opengovern/og-describer-azure/provider/describer"
 	"github.com/opengovern/og-util/pkg/describe/enums"
+	"go.uber.org/zap"
 )
 
-func DescribeBySubscription(describe func(context.Context, *azidentity.ClientSecretCredential, string, *model.StreamSender) ([]model.Resource, error)) model.ResourceDescriber {
+type subscriptionDescriber func(context.Context, azcore.TokenCredential, string, *model.StreamSender) ([]model.Resource, error)
+
+// resourceDescriber describes the resource with the ARM id in the
+// subscription.
+type resourceDescriber func(ctx context.Context, cred azcore.TokenCredential, subscription string, resourceID string) (*model.Resource, error)
+
+// describeJob is what the describers of a job share.
+type describeJob struct {
+	cfg      configs.IntegrationCredentials
+	cloud    describer.Cloud
+	cred     azcore.TokenCredential
+	ancestry describer.ManagementGroupAncestry
+
+	// subscriptionIDs are the subscriptions the job covers, a single one
+	// unless the job is tenant or management group scoped.
+	subscriptionIDs         []string
+	scoped                  bool
+	subscriptionConcurrency int
+}
+
+func newDescribeJob(ctx context.Context, cfg configs.IntegrationCredentials, triggerType enums.DescribeTriggerType) (context.Context, *describeJob, error) {
+	ctx = describer.WithTriggerType(ctx, triggerType)
+	cloud, err := describer.CloudByName(cfg.Cloud)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = describer.WithCloud(ctx, cloud)
+	ctx = describer.WithTenantID(ctx, cfg.TenantID)
+	cred, err := NewTokenCredential(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ctx, &describeJob{cfg: cfg, cloud: cloud, cred: cred}, nil
+}
+
+// resolveScope finds the subscriptions the job covers.
+func (j *describeJob) resolveScope(ctx context.Context, additionalData map[string]string) error {
+	if groupID, subscriptionConcurrency, ok := describer.GetManagementGroupScopeFromContext(ctx); ok {
+		var err error
+		j.ancestry, err = describer.GetManagementGroupAncestry(ctx, j.cred, j.cfg.TenantID, j.credentialID())
+		if err != nil {
+			return err
+		}
+		subscriptionIDs, err := describer.ListSubscriptionIDs(ctx, j.cred)
+		if err != nil {
+			return err
+		}
+		j.subscriptionIDs = underGroup(subscriptionIDs, j.ancestry, groupID)
+		j.scoped, j.subscriptionConcurrency = true, subscriptionConcurrency
+		return nil
+	}
+
+	if ancestryWanted(ctx) {
+		j.loadAncestry(ctx)
+	}
+	if subscriptionConcurrency, ok := describer.GetTenantScopeFromContext(ctx); ok {
+		var err error
+		j.subscriptionIDs, err = describer.ListSubscriptionIDs(ctx, j.cred)
+		if err != nil {
+			return err
+		}
+		j.scoped, j.subscriptionConcurrency = true, subscriptionConcurrency
+		return nil
+	}
+	j.subscriptionIDs = []string{additionalData["subscriptionId"]}
+	return nil
+}
+
+// ancestryWanted reports whether the resources of the job record their
+// management group path. Listing the hierarchy is a call of its own, a
+// subscription scoped job only makes it if asked to.
+func ancestryWanted(ctx context.Context) bool {
+	if _, ok := describer.GetTenantScopeFromContext(ctx); ok {
+		return true
+	}
+	return describer.GetManagementGroupPathFromContext(ctx)
+}
+
+// loadAncestry loads the management group hierarchy, it's only metadata
+// outside of the management group scope so the job goes on without it. The
+// failure is logged where the hierarchy is cached.
+func (j *describeJob) loadAncestry(ctx context.Context) {
+	j.ancestry, _ = describer.GetManagementGroupAncestry(ctx, j.cred, j.cfg.TenantID, j.credentialID())
+}
+
+// credentialID tells apart the credentials of a tenant, the identity they
+// authenticate as and how.
+func (j *describeJob) credentialID() string {
+	return string(j.cfg.Type()) + "/" + j.cfg.ClientID
+}
+
+// accountInfo is recorded on every resource of the subscription.
+func (j *describeJob) accountInfo(subscriptionID string) map[string]string {
+	return map[string]string{
+		"SubscriptionID":      subscriptionID,
+		"TenantID":            j.cfg.TenantID,
+		"CloudEnvironment":    j.cloud.Name,
+		"ManagementGroupPath": j.ancestry.Path(subscriptionID),
+	}
+}
+
+func DescribeBySubscription(describe subscriptionDescriber) model.ResourceDescriber {
 	return func(ctx context.Context, cfg configs.IntegrationCredentials, triggerType enums.DescribeTriggerType, additionalData map[string]string, stream *model.StreamSender) ([]model.Resource, error) {
-		ctx = describer.WithTriggerType(ctx, triggerType)
-		cred, err := azidentity.NewClientSecretCredential(cfg.TenantID, cfg.ClientID, cfg.ClientPassword, nil)
+		ctx, job, err := newDescribeJob(ctx, cfg, triggerType)
 		if err != nil {
 			return nil, err
 		}
-		var values []model.Resource
-		result, err := describe(ctx, cred, additionalData["subscriptionId"], stream)
+		if err := job.resolveScope(ctx, additionalData); err != nil {
+			return nil, err
+		}
+		if job.scoped {
+			return job.describeSubscriptions(ctx, describe, stream)
+		}
+		return job.describeSubscription(ctx, describe, job.subscriptionIDs[0], stream)
+	}
+}
+
+// DescribeByResourceGraph lists the resources of every subscription of the
+// job with a few Resource Graph queries instead of the ARM list APIs.
+func DescribeByResourceGraph(d describer.GenericResourceGraph) model.ResourceDescriber {
+	return func(ctx context.Context, cfg configs.IntegrationCredentials, triggerType enums.DescribeTriggerType, additionalData map[string]string, stream *model.StreamSender) ([]model.Resource, error) {
+		ctx, job, err := newDescribeJob(ctx, cfg, triggerType)
 		if err != nil {
 			return nil, err
 		}
-		accountInfo := map[string]string{
-			"SubscriptionID": additionalData["subscriptionId"],
-			"TenantID":       cfg.TenantID,
+		if err := job.resolveScope(ctx, additionalData); err != nil {
+			return nil, err
 		}
-		for _, resource := range result {
-			resource.AccountInfo = accountInfo
+		if stream != nil {
+			next := *stream
+			withAccountInfo := model.StreamSender(func(resource model.Resource) error {
+				resource.AccountInfo = job.accountInfo(subscriptionIDOf(resource.ID))
+				return next(resource)
+			})
+			stream = &withAccountInfo
 		}
-		values = append(values, result...)
 
-		return values, nil
+		result, err := d.DescribeResources(ctx, job.cred, nil, job.subscriptionIDs, cfg.TenantID, triggerType, stream)
+		if err != nil {
+			return nil, err
+		}
+		for i := range result {
+			result[i].AccountInfo = job.accountInfo(subscriptionIDOf(result[i].ID))
+		}
+		return result, nil
+	}
+}
+
+// GetBySubscription describes the resource of the resourceId additional
+// data. It returns describer.ErrResourceNotFound if the resource is gone, and
+// no resource if it was skipped.
+func GetBySubscription(describe resourceDescriber) model.SingleResourceDescriber {
+	return func(ctx context.Context, cfg configs.IntegrationCredentials, triggerType enums.DescribeTriggerType, additionalData map[string]string) (*model.Resource, error) {
+		ctx, job, err := newDescribeJob(ctx, cfg, triggerType)
+		if err != nil {
+			return nil, err
+		}
+		resourceID := additionalData["resourceId"]
+		subscriptionID := subscriptionIDOf(resourceID)
+		if subscriptionID == "" {
+			return nil, fmt.Errorf("invalid resource id %s", resourceID)
+		}
+		if ancestryWanted(ctx) {
+			job.loadAncestry(ctx)
+		}
+
+		resource, err := describe(ctx, job.cred, subscriptionID, resourceID)
+		if err != nil {
+			return nil, err
+		}
+		if resource != nil {
+			resource.AccountInfo = job.accountInfo(subscriptionID)
+		}
+		return resource, nil
+	}
+}
+
+// subscriptionIDOf returns the subscription of an ARM resource id.
+func subscriptionIDOf(resourceID string) string {
+	parts := strings.Split(resourceID, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if strings.EqualFold(parts[i], "subscriptions") {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// underGroup keeps the subscriptions that sit under the management group.
+func underGroup(subscriptionIDs []string, ancestry describer.ManagementGroupAncestry, groupID string) []string {
+	under := map[string]bool{}
+	for _, id := range ancestry.Under(groupID) {
+		under[strings.ToLower(id)] = true
+	}
+	var ids []string
+	for _, id := range subscriptionIDs {
+		if under[strings.ToLower(id)] {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// describeSubscription describes one subscription, recording it on every
+// resource.
+func (j *describeJob) describeSubscription(ctx context.Context, describe subscriptionDescriber, subscriptionID string, stream *model.StreamSender) ([]model.Resource, error) {
+	accountInfo := j.accountInfo(subscriptionID)
+	if stream != nil {
+		next := *stream
+		withAccountInfo := model.StreamSender(func(resource model.Resource) error {
+			resource.AccountInfo = accountInfo
+			return next(resource)
+		})
+		stream = &withAccountInfo
+	}
+
+	var values []model.Resource
+	result, err := describe(ctx, j.cred, subscriptionID, stream)
+	if err != nil {
+		return nil, err
+	}
+	for i := range result {
+		result[i].AccountInfo = accountInfo
+	}
+	values = append(values, result...)
+
+	return values, nil
+}
+
+// describeSubscriptions describes the subscriptions of a tenant or management
+// group scoped job, a few at a time.
+func (j *describeJob) describeSubscriptions(ctx context.Context, describe subscriptionDescriber, stream *model.StreamSender) ([]model.Resource, error) {
+	describer.GetLoggerFromContext(ctx).Info("describing subscriptions", zap.Int("subscriptions", len(j.subscriptionIDs)))
+
+	// the job stream is not safe for concurrent use
+	if stream != nil {
+		var lock sync.Mutex
+		next := *stream
+		serialized := model.StreamSender(func(resource model.Resource) error {
+			lock.Lock()
+			defer lock.Unlock()
+			return next(resource)
+		})
+		stream = &serialized
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wp := concurrency.NewWorkPool(j.subscriptionConcurrency)
+	for _, subscriptionID := range j.subscriptionIDs {
+		subscriptionID := subscriptionID
+		wp.AddJob(func() (interface{}, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			result, err := j.describeSubscription(ctx, describe, subscriptionID, stream)
+			if err != nil {
+				if describer.Tolerate(ctx, "/subscriptions/"+subscriptionID, "DescribeSubscription", err) {
+					return nil, nil
+				}
+				// the other subscriptions are not worth describing anymore
+				cancel()
+				return nil, err
+			}
+			return result, nil
+		})
+	}
+
+	var values []model.Resource
+	var firstErr error
+	for _, r := range wp.Run() {
+		if r.Error != nil {
+			// the subscriptions cancelled after a failure only report that
+			if firstErr == nil || errors.Is(firstErr, context.Canceled) {
+				firstErr = r.Error
+			}
+			continue
+		}
+		if result, ok := r.Value.([]model.Resource); ok {
+			values = append(values, result...)
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
 	}
+	return values, nil
 }
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestSubscriptionIDOf(t *testing.T) {
+	tests := map[string]string{
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Sql/servers/s": "sub",
+		"/SUBSCRIPTIONS/sub": "sub",
+		"/providers/Microsoft.Management/managementGroups/group": "",
+		"": "",
+	}
+	for id, want := range tests {
+		if got := subscriptionIDOf(id); got != want {
+			t.Errorf("subscriptionIDOf(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
@@ -1,18 +1,99 @@
 package provider
 
 import (
-	
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/microsoftgraph/msgraph-sdk-go/models/odataerrors"
 )
 
+// Error codes reported to the control plane for failed describe jobs.
 const (
-	ErrSubscriptionRequired = "SubscriptionRequiredException"
+	ErrCodeAuthorizationFailed   = "AuthorizationFailed"
+	ErrCodeInvalidCredentials    = "InvalidCredentials"
+	ErrCodeSubscriptionNotFound  = "SubscriptionNotFound"
+	ErrCodeSubscriptionDisabled  = "SubscriptionDisabled"
+	ErrCodeProviderNotRegistered = "ResourceProviderNotRegistered"
+	ErrCodeThrottled             = "Throttled"
+	ErrCodeAzureUnavailable      = "AzureUnavailable"
+	ErrCodeAzureRequestFailed    = "AzureRequestFailed"
+	ErrCodeTimeout               = "Timeout"
 )
 
-// TODO
+// azureErrorCodes maps the error codes of ARM and MS Graph to ours, the
+// ones that are not listed are classified by their status code.
+var azureErrorCodes = map[string]string{
+	"authorizationfailed":                 ErrCodeAuthorizationFailed,
+	"linkedauthorizationfailed":           ErrCodeAuthorizationFailed,
+	"authorization_requestdenied":         ErrCodeAuthorizationFailed,
+	"accessdenied":                        ErrCodeAuthorizationFailed,
+	"forbidden":                           ErrCodeAuthorizationFailed,
+	"invalidauthenticationtoken":          ErrCodeInvalidCredentials,
+	"invalidauthenticationtokentenant":    ErrCodeInvalidCredentials,
+	"expiredauthenticationtoken":          ErrCodeInvalidCredentials,
+	"authenticationfailed":                ErrCodeInvalidCredentials,
+	"subscriptionnotfound":                ErrCodeSubscriptionNotFound,
+	"invalidsubscriptionid":               ErrCodeSubscriptionNotFound,
+	"readonlydisabledsubscription":        ErrCodeSubscriptionDisabled,
+	"disabledsubscription":                ErrCodeSubscriptionDisabled,
+	"subscriptiondisabled":                ErrCodeSubscriptionDisabled,
+	"missingsubscriptionregistration":     ErrCodeProviderNotRegistered,
+	"subscriptionnotregistered":           ErrCodeProviderNotRegistered,
+	"toomanyrequests":                     ErrCodeThrottled,
+	"subscriptionrequeststhrottled":       ErrCodeThrottled,
+	"tenantrequeststhrottled":             ErrCodeThrottled,
+	"resourcecollectionrequeststhrottled": ErrCodeThrottled,
+}
+
+// ClassifyError returns the error code of a describe failure, or an empty
+// string if it isn't an error we know of.
+func ClassifyError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var authErr *azidentity.AuthenticationFailedError
+	if errors.As(err, &authErr) {
+		return ErrCodeInvalidCredentials
+	}
+
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) {
+		return classifyResponse(respErr.ErrorCode, respErr.StatusCode)
+	}
+
+	var graphErr *odataerrors.ODataError
+	if errors.As(err, &graphErr) {
+		code := ""
+		if main := graphErr.GetErrorEscaped(); main != nil && main.GetCode() != nil {
+			code = *main.GetCode()
+		}
+		return classifyResponse(code, graphErr.GetStatusCode())
+	}
 
-func hasError(resource any) bool {
-	// You should implement this function.
-	// This function Check if Authorization Error is present in the resource.
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrCodeTimeout
+	}
+	return ""
+}
 
-	return false
-}
\ No newline at end of file
+func classifyResponse(code string, statusCode int) string {
+	if c, ok := azureErrorCodes[strings.ToLower(code)]; ok {
+		return c
+	}
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		return ErrCodeThrottled
+	case statusCode == http.StatusUnauthorized:
+		return ErrCodeInvalidCredentials
+	case statusCode == http.StatusForbidden:
+		return ErrCodeAuthorizationFailed
+	case statusCode >= 500:
+		return ErrCodeAzureUnavailable
+	}
+	return ErrCodeAzureRequestFailed
+}
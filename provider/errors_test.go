@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "Nil",
+			err:  nil,
+			want: "",
+		},
+		{
+			name: "AuthorizationFailed",
+			err:  &azcore.ResponseError{ErrorCode: "AuthorizationFailed", StatusCode: http.StatusForbidden},
+			want: ErrCodeAuthorizationFailed,
+		},
+		{
+			name: "Wrapped",
+			err:  fmt.Errorf("list disks: %w", &azcore.ResponseError{ErrorCode: "SubscriptionNotFound", StatusCode: http.StatusNotFound}),
+			want: ErrCodeSubscriptionNotFound,
+		},
+		{
+			name: "ProviderNotRegistered",
+			err:  &azcore.ResponseError{ErrorCode: "MissingSubscriptionRegistration", StatusCode: http.StatusConflict},
+			want: ErrCodeProviderNotRegistered,
+		},
+		{
+			name: "Throttled",
+			err:  &azcore.ResponseError{StatusCode: http.StatusTooManyRequests},
+			want: ErrCodeThrottled,
+		},
+		{
+			name: "ServerError",
+			err:  &azcore.ResponseError{ErrorCode: "InternalServerError", StatusCode: http.StatusServiceUnavailable},
+			want: ErrCodeAzureUnavailable,
+		},
+		{
+			name: "OtherRequestError",
+			err:  &azcore.ResponseError{ErrorCode: "InvalidApiVersionParameter", StatusCode: http.StatusBadRequest},
+			want: ErrCodeAzureRequestFailed,
+		},
+		{
+			name: "Timeout",
+			err:  fmt.Errorf("next page: %w", context.DeadlineExceeded),
+			want: ErrCodeTimeout,
+		},
+		{
+			name: "Unknown",
+			err:  fmt.Errorf("something else"),
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyError(tt.err); got != tt.want {
+				t.Errorf("ClassifyError() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
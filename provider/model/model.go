@@ -105,6 +105,9 @@ type Metadata struct {
 	CloudEnvironment string
 	ResourceType     string
 	IntegrationID    string
+	// ManagementGroupPath is the management group ancestry of the
+	// subscription, from the root group down, joined with '/'.
+	ManagementGroupPath string `json:",omitempty"`
 }
 
 //  ===================  APIManagement ==================
@@ -2,6 +2,7 @@ package provider
 import (
 	"github.com/opengovern/og-describer-azure/provider/describer"
 	"github.com/opengovern/og-describer-azure/provider/configs"
+	azuremodel "github.com/opengovern/og-describer-azure/provider/model"
 	model "github.com/opengovern/og-describer-azure/pkg/sdk/models"
 )
 var ResourceTypes = map[string]model.ResourceType{
@@ -18,7 +19,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AppContainerApps),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AppContainerAppByID),
 	},
 
 	"Microsoft.Blueprint/blueprints": {
@@ -32,7 +33,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.BlueprintBlueprint),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.BlueprintBlueprintByID),
 	},
 
 	"Microsoft.Cdn/profiles": {
@@ -47,7 +48,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.CdnProfiles),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.CdnProfileByID),
 	},
 
 	"Microsoft.Compute/cloudServices": {
@@ -62,7 +63,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeCloudServices),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeCloudServiceByID),
 	},
 
 	"Microsoft.ContainerInstance/containerGroups": {
@@ -77,7 +78,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ContainerInstanceContainerGroups),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ContainerInstanceContainerGroupByID),
 	},
 
 	"Microsoft.DataMigration/services": {
@@ -92,7 +93,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DataMigrationServices),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DataMigrationServiceByID),
 	},
 
 	"Microsoft.DataProtection/backupVaults": {
@@ -106,7 +107,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DataProtectionBackupVaults),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DataProtectionBackupVaultByID),
 	},
 
 	"Microsoft.DataProtection/backupJobs": {
@@ -120,7 +121,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DataProtectionBackupJobs),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DataProtectionBackupJobByID),
 	},
 
 	"Microsoft.DataProtection/backupVaults/backupPolicies": {
@@ -134,7 +135,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DataProtectionBackupVaultsBackupPolicies),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DataProtectionBackupVaultsBackupPolicyByID),
 	},
 
 	"Microsoft.Logic/integrationAccounts": {
@@ -149,7 +150,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LogicIntegrationAccounts),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LogicIntegrationAccountByID),
 	},
 
 	"Microsoft.Network/bastionHosts": {
@@ -164,7 +165,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkBastionHosts),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkBastionHostByID),
 	},
 
 	"Microsoft.Network/connections": {
@@ -179,7 +180,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkConnections),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkConnectionByID),
 	},
 
 	"Microsoft.Network/firewallPolicies": {
@@ -193,7 +194,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.FirewallPolicy),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.FirewallPolicyByID),
 	},
 
 	"Microsoft.Network/localNetworkGateways": {
@@ -208,7 +209,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LocalNetworkGateway),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LocalNetworkGatewayByID),
 	},
 
 	"Microsoft.Network/privateLinkServices": {
@@ -222,7 +223,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.PrivateLinkService),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.PrivateLinkServiceByID),
 	},
 
 	"Microsoft.Network/publicIPPrefixes": {
@@ -237,7 +238,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.PublicIPPrefix),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.PublicIPPrefixByID),
 	},
 
 	"Microsoft.Network/virtualHubs": {
@@ -252,7 +253,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkVirtualHubs),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkVirtualHubByID),
 	},
 
 	"Microsoft.Network/virtualWans": {
@@ -267,7 +268,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkVirtualWans),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkVirtualWanByID),
 	},
 
 	"Microsoft.Network/vpnGateways": {
@@ -282,7 +283,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.VpnGateway),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.VpnGatewayByID),
 	},
 
 	"Microsoft.Network/vpnGateways/vpnConnections": {
@@ -295,7 +296,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkVpnGatewaysVpnConnections),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkVpnGatewaysVpnConnectionByID),
 	},
 
 	"Microsoft.Network/vpnSites": {
@@ -309,7 +310,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkVpnGatewaysVpnSites),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkVpnGatewaysVpnSiteByID),
 	},
 
 	"Microsoft.OperationalInsights/workspaces": {
@@ -322,7 +323,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.OperationalInsightsWorkspaces),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.OperationalInsightsWorkspaceByID),
 	},
 
 	"Microsoft.StreamAnalytics/cluster": {
@@ -336,7 +337,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.StreamAnalyticsCluster),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.StreamAnalyticsClusterByID),
 	},
 
 	"Microsoft.TimeSeriesInsights/environments": {
@@ -350,7 +351,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.TimeSeriesInsightsEnvironments),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.TimeSeriesInsightsEnvironmentByID),
 	},
 
 	"Microsoft.VirtualMachineImages/imageTemplates": {
@@ -365,7 +366,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.VirtualMachineImagesImageTemplates),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.VirtualMachineImagesImageTemplateByID),
 	},
 
 	"Microsoft.Web/serverFarms": {
@@ -380,7 +381,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.WebServerFarms),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.WebServerFarmByID),
 	},
 
 	"Microsoft.Compute/virtualMachineScaleSets/virtualMachines": {
@@ -394,7 +395,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeVirtualMachineScaleSetVm),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeVirtualMachineScaleSetVmByID),
 	},
 
 	"Microsoft.Automation/automationAccounts": {
@@ -409,7 +410,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AutomationAccounts),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AutomationAccountByID),
 	},
 
 	"Microsoft.Automation/automationAccounts/variables": {
@@ -424,7 +425,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AutomationVariables),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AutomationVariableByID),
 	},
 
 	"Microsoft.Network/dnsZones": {
@@ -439,7 +440,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DNSZones),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DNSZoneByID),
 	},
 
 	"Microsoft.Databricks/workspaces": {
@@ -454,7 +455,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DatabricksWorkspaces),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DatabricksWorkspaceByID),
 	},
 
 	"Microsoft.Network/privateDnsZones": {
@@ -469,7 +470,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.PrivateDnsZones),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.PrivateDnsZoneByID),
 	},
 
 	"Microsoft.Network/privateEndpoints": {
@@ -484,7 +485,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.PrivateEndpoints),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.PrivateEndpointByID),
 	},
 
 	"Microsoft.Network/networkWatchers": {
@@ -498,7 +499,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkWatcher),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkWatcherByID),
 	},
 
 	"Microsoft.Resources/subscriptions/resourceGroups": {
@@ -512,7 +513,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ResourceGroup),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ResourceGroupByID),
 	},
 
 	"Microsoft.Web/staticSites": {
@@ -527,7 +528,8 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AppServiceWebApp),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AppServiceWebAppByID),
+		Redact:               []string{"$..AppSettings[*].Value", "$..ConnectionStrings[*].ConnectionString"},
 	},
 
 	"Microsoft.Web/sites/slots": {
@@ -542,7 +544,8 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AppServiceWebAppSlot),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AppServiceWebAppSlotByID),
+		Redact:               []string{"$..AppSettings[*].Value", "$..ConnectionStrings[*].ConnectionString"},
 	},
 
 	"Microsoft.CognitiveServices/accounts": {
@@ -557,7 +560,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.CognitiveAccount),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.CognitiveAccountByID),
 	},
 
 	"Microsoft.Sql/managedInstances": {
@@ -572,7 +575,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.MssqlManagedInstance),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.MssqlManagedInstanceByID),
 	},
 
 	"Microsoft.Sql/virtualclusters": {
@@ -587,7 +590,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SqlVirtualClusters),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SqlVirtualClusterByID),
 	},
 
 	"Microsoft.Sql/managedInstances/databases": {
@@ -602,7 +605,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.MssqlManagedInstanceDatabases),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.MssqlManagedInstanceDatabaseByID),
 	},
 
 	"Microsoft.Sql/servers/databases": {
@@ -617,7 +620,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SqlDatabase),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SqlDatabaseByID),
 	},
 
 	"Microsoft.Storage/storageAccounts/largeFileSharesState": {
@@ -632,7 +635,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.StorageFileShare),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.StorageFileShareByID),
 	},
 
 	"Microsoft.DBforPostgreSQL/servers": {
@@ -647,7 +650,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.PostgresqlServer),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.PostgresqlServerByID),
 	},
 
 	"Microsoft.DBforPostgreSQL/flexibleservers": {
@@ -662,7 +665,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.PostgresqlFlexibleservers),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.PostgresqlFlexibleserverByID),
 	},
 
 	"Microsoft.AnalysisServices/servers": {
@@ -677,7 +680,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AnalysisService),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AnalysisServiceByID),
 	},
 
 	"Microsoft.Security/pricings": {
@@ -690,7 +693,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SecurityCenterSubscriptionPricing),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SecurityCenterSubscriptionPricingByID),
 	},
 
 	"Microsoft.Insights/guestDiagnosticSettings": {
@@ -704,7 +707,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DiagnosticSetting),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DiagnosticSettingByID),
 	},
 
 	"Microsoft.Insights/autoscaleSettings": {
@@ -718,7 +721,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AutoscaleSetting),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AutoscaleSettingByID),
 	},
 
 	"Microsoft.Web/hostingEnvironments": {
@@ -733,7 +736,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AppServiceEnvironment),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AppServiceEnvironmentByID),
 	},
 
 	"Microsoft.Cache/redis": {
@@ -748,7 +751,8 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.RedisCache),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.RedisCacheByID),
+		Redact:               []string{"$..AccessKeys", "$..RedisConfiguration.AofStorageConnectionString0", "$..RedisConfiguration.AofStorageConnectionString1", "$..RedisConfiguration.RdbStorageConnectionString"},
 	},
 
 	"Microsoft.ContainerRegistry/registries": {
@@ -763,7 +767,8 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ContainerRegistry),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ContainerRegistryByID),
+		Redact:               []string{"$..RegistryListCredentialsResult.Passwords[*].Value"},
 	},
 
 	"Microsoft.DataFactory/factories/pipelines": {
@@ -777,7 +782,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DataFactoryPipeline),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DataFactoryPipelineByID),
 	},
 
 	"Microsoft.Compute/resourceSku": {
@@ -805,7 +810,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ExpressRouteCircuit),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ExpressRouteCircuitByID),
 	},
 
 	"Microsoft.Management/managementgroups": {
@@ -819,7 +824,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ManagementGroup),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ManagementGroupByID),
 	},
 
 	"microsoft.SqlVirtualMachine/SqlVirtualMachines": {
@@ -834,7 +839,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SqlServerVirtualMachine),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SqlServerVirtualMachineByID),
 	},
 
 	"Microsoft.SqlVirtualMachine/SqlVirtualMachineGroups": {
@@ -849,7 +854,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SqlServerVirtualMachineGroups),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SqlServerVirtualMachineGroupByID),
 	},
 
 	"Microsoft.Storage/storageAccounts/tableServices": {
@@ -864,7 +869,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.StorageTableService),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.StorageTableServiceByID),
 	},
 
 	"Microsoft.Synapse/workspaces": {
@@ -879,7 +884,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SynapseWorkspace),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SynapseWorkspaceByID),
 	},
 
 	"Microsoft.Synapse/workspaces/bigdatapools": {
@@ -894,7 +899,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SynapseWorkspaceBigdataPools),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SynapseWorkspaceBigdataPoolByID),
 	},
 
 	"Microsoft.Synapse/workspaces/sqlpools": {
@@ -909,7 +914,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SynapseWorkspaceSqlpools),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SynapseWorkspaceSqlpoolByID),
 	},
 
 	"Microsoft.StreamAnalytics/streamingJobs": {
@@ -923,7 +928,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.StreamAnalyticsJob),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.StreamAnalyticsJobByID),
 	},
 
 	"Microsoft.CostManagement/CostBySubscription": {
@@ -951,7 +956,8 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.KubernetesCluster),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.KubernetesClusterByID),
+		Redact:               []string{"$..ServicePrincipalProfile.Secret", "$..AADProfile.ServerAppSecret", "$..WindowsProfile.AdminPassword", "$..KubeConfig"},
 	},
 
 	"Microsoft.ContainerService/serviceVersions": {
@@ -980,7 +986,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DataFactory),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DataFactoryByID),
 	},
 
 	"Microsoft.Sql/servers": {
@@ -995,7 +1001,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SqlServer),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SqlServerByID),
 	},
 
 	"Microsoft.Sql/servers/jobagents": {
@@ -1010,7 +1016,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SqlServerJobAgents),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SqlServerJobAgentByID),
 	},
 
 	"Microsoft.Security/autoProvisioningSettings": {
@@ -1023,7 +1029,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SecurityCenterAutoProvisioning),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SecurityCenterAutoProvisioningByID),
 	},
 
 	"Microsoft.Insights/logProfiles": {
@@ -1036,7 +1042,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LogProfile),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LogProfileByID),
 	},
 
 	"Microsoft.DataBoxEdge/dataBoxEdgeDevices": {
@@ -1051,7 +1057,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DataboxEdgeDevice),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DataboxEdgeDeviceByID),
 	},
 
 	"Microsoft.Network/loadBalancers": {
@@ -1066,7 +1072,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LoadBalancer),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LoadBalancerByID),
 	},
 
 	"Microsoft.Network/azureFirewalls": {
@@ -1081,7 +1087,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkAzureFirewall),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkAzureFirewallByID),
 	},
 
 	"Microsoft.Management/locks": {
@@ -1095,7 +1101,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ManagementLock),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ManagementLockByID),
 	},
 
 	"Microsoft.Compute/virtualMachineScaleSets/networkInterfaces": {
@@ -1109,7 +1115,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeVirtualMachineScaleSetNetworkInterface),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeVirtualMachineScaleSetNetworkInterfaceByID),
 	},
 
 	"Microsoft.Network/frontDoors": {
@@ -1124,7 +1130,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.FrontDoor),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.FrontDoorByID),
 	},
 
 	"Microsoft.Authorization/policyAssignments": {
@@ -1139,7 +1145,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.PolicyAssignment),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.PolicyAssignmentByID),
 	},
 
 	"Microsoft.Authorization/userEffectiveAccess": {
@@ -1169,7 +1175,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SearchService),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SearchServiceByID),
 	},
 
 	"Microsoft.Security/settings": {
@@ -1182,7 +1188,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SecurityCenterSetting),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SecurityCenterSettingByID),
 	},
 
 	"Microsoft.RecoveryServices/vaults": {
@@ -1196,7 +1202,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.RecoveryServicesVault),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.RecoveryServicesVaultByID),
 	},
 
 	"Microsoft.RecoveryServices/vaults/backupJobs": {
@@ -1253,7 +1259,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeDiskEncryptionSet),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeDiskEncryptionSetByID),
 	},
 
 	"Microsoft.DocumentDB/databaseAccounts/sqlDatabases": {
@@ -1268,7 +1274,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DocumentDBSQLDatabase),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DocumentDBSQLDatabaseByID),
 	},
 
 	"Microsoft.EventGrid/topics": {
@@ -1282,8 +1288,8 @@ var ResourceTypes = map[string]model.ResourceType{
         },
 		Annotations:          map[string]string{
         },
-		ListDescriber:        DescribeBySubscription(describer.EventGridTopic),
-		GetDescriber:         nil,
+		ListDescriber:        DescribeByResourceGraph(describer.GenericResourceGraph{Table: "Resources", Type: "Microsoft.EventGrid/topics", Describe: describer.EventGridTopicHydrator}),
+		GetDescriber:         GetBySubscription(describer.EventGridTopicByID),
 	},
 
 	"Microsoft.EventHub/namespaces": {
@@ -1298,7 +1304,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.EventhubNamespace),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.EventhubNamespaceByID),
 	},
 
 	"Microsoft.EventHub/namespaces/eventHubs": {
@@ -1313,7 +1319,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.EventhubNamespaceEventhub),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.EventhubNamespaceEventhubByID),
 	},
 
 	"Microsoft.MachineLearningServices/workspaces": {
@@ -1328,7 +1334,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.MachineLearningWorkspace),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.MachineLearningWorkspaceByID),
 	},
 
 	"Microsoft.Dashboard/grafana": {
@@ -1343,7 +1349,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DashboardGrafana),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DashboardGrafanaByID),
 	},
 
 	"Microsoft.DesktopVirtualization/workspaces": {
@@ -1358,7 +1364,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DesktopVirtualizationWorkspaces),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DesktopVirtualizationWorkspaceByID),
 	},
 
 	"Microsoft.Network/trafficManagerProfiles": {
@@ -1373,7 +1379,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.TrafficManagerProfile),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.TrafficManagerProfileByID),
 	},
 
 	"Microsoft.Network/dnsResolvers": {
@@ -1388,7 +1394,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DNSResolvers),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DNSResolverByID),
 	},
 
 	"Microsoft.CostManagement/CostByResourceType": {
@@ -1416,7 +1422,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkInterface),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkInterfaceByID),
 	},
 
 	"Microsoft.Network/publicIPAddresses": {
@@ -1430,8 +1436,8 @@ var ResourceTypes = map[string]model.ResourceType{
         },
 		Annotations:          map[string]string{
         },
-		ListDescriber:        DescribeBySubscription(describer.PublicIPAddress),
-		GetDescriber:         nil,
+		ListDescriber:        DescribeByResourceGraph(describer.GenericResourceGraph{Table: "Resources", Type: "Microsoft.Network/publicIPAddresses", Describe: describer.ResourceGraphDescription[azuremodel.PublicIPAddressDescription]}),
+		GetDescriber:         GetBySubscription(describer.PublicIPAddressByID),
 	},
 
 	"Microsoft.HealthcareApis/services": {
@@ -1444,7 +1450,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.HealthcareService),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.HealthcareServiceByID),
 	},
 
 	"Microsoft.ServiceBus/namespaces": {
@@ -1459,7 +1465,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ServicebusNamespace),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ServicebusNamespaceByID),
 	},
 
 	"Microsoft.Web/sites": {
@@ -1474,7 +1480,8 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AppServiceFunctionApp),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AppServiceFunctionAppByID),
+		Redact:               []string{"$..AppSettings[*].Value", "$..ConnectionStrings[*].ConnectionString"},
 	},
 
 	"Microsoft.Compute/availabilitySets": {
@@ -1489,7 +1496,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeAvailabilitySet),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeAvailabilitySetByID),
 	},
 
 	"Microsoft.Network/virtualNetworks": {
@@ -1504,7 +1511,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.VirtualNetwork),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.VirtualNetworkByID),
 	},
 
 	"Microsoft.Security/securityContacts": {
@@ -1517,7 +1524,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SecurityCenterContact),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SecurityCenterContactByID),
 	},
 
 	"Microsoft.Compute/diskswriteops": {
@@ -1557,8 +1564,8 @@ var ResourceTypes = map[string]model.ResourceType{
         },
 		Annotations:          map[string]string{
         },
-		ListDescriber:        DescribeBySubscription(describer.EventGridDomain),
-		GetDescriber:         nil,
+		ListDescriber:        DescribeByResourceGraph(describer.GenericResourceGraph{Table: "Resources", Type: "Microsoft.EventGrid/domains", Describe: describer.EventGridDomainHydrator}),
+		GetDescriber:         GetBySubscription(describer.EventGridDomainByID),
 	},
 
 	"Microsoft.KeyVault/deletedVaults": {
@@ -1573,7 +1580,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DeletedVault),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DeletedVaultByID),
 	},
 
 	"Microsoft.Storage/storageAccounts/tableServices/tables": {
@@ -1588,7 +1595,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.StorageTable),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.StorageTableByID),
 	},
 
 	"Microsoft.Compute/snapshots": {
@@ -1603,7 +1610,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeSnapshots),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeSnapshotByID),
 	},
 
 	"Microsoft.Kusto/clusters": {
@@ -1618,7 +1625,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.KustoCluster),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.KustoClusterByID),
 	},
 
 	"Microsoft.StorageSync/storageSyncServices": {
@@ -1633,7 +1640,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.StorageSync),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.StorageSyncByID),
 	},
 
 	"Microsoft.Security/locations/jitNetworkAccessPolicies": {
@@ -1646,7 +1653,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SecurityCenterJitNetworkAccessPolicy),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SecurityCenterJitNetworkAccessPolicyByID),
 	},
 
 	"Microsoft.Network/virtualNetworks/subnets": {
@@ -1661,7 +1668,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.Subnet),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SubnetByID),
 	},
 
 	"Microsoft.Network/loadBalancers/backendAddressPools": {
@@ -1676,7 +1683,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LoadBalancerBackendAddressPool),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LoadBalancerBackendAddressPoolByID),
 	},
 
 	"Microsoft.Network/loadBalancers/loadBalancingRules": {
@@ -1690,7 +1697,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LoadBalancerRule),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LoadBalancerRuleByID),
 	},
 
 	"Microsoft.Compute/virtualMachineCpuUtilizationDaily": {
@@ -1718,7 +1725,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DataLakeStore),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DataLakeStoreByID),
 	},
 
 	"Microsoft.StorageCache/caches": {
@@ -1733,7 +1740,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.HpcCache),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.HpcCacheByID),
 	},
 
 	"Microsoft.Batch/batchAccounts": {
@@ -1748,7 +1755,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.BatchAccount),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.BatchAccountByID),
 	},
 
 	"Microsoft.Network/networkSecurityGroups": {
@@ -1763,7 +1770,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkSecurityGroup),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkSecurityGroupByID),
 	},
 
 	"Microsoft.Authorization/roleDefinitions": {
@@ -1778,7 +1785,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.RoleDefinition),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.RoleDefinitionByID),
 	},
 
 	"Microsoft.Network/applicationSecurityGroups": {
@@ -1793,7 +1800,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkApplicationSecurityGroups),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkApplicationSecurityGroupByID),
 	},
 
 	"Microsoft.Authorization/roleAssignment": {
@@ -1806,7 +1813,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.RoleAssignment),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.RoleAssignmentByID),
 	},
 
 	"Microsoft.DocumentDB/databaseAccounts/mongodbDatabases": {
@@ -1821,7 +1828,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DocumentDBMongoDatabase),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DocumentDBMongoDatabaseByID),
 	},
 
 	"Microsoft.DocumentDB/databaseAccounts/mongodbDatabases/collections": {
@@ -1836,7 +1843,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DocumentDBMongoCollection),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DocumentDBMongoCollectionByID),
 	},
 
 	"Microsoft.Network/networkWatchers/flowLogs": {
@@ -1849,7 +1856,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkWatcherFlowLog),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkWatcherFlowLogByID),
 	},
 
 	"microsoft.Sql/servers/elasticpools": {
@@ -1864,7 +1871,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SqlServerElasticPool),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SqlServerElasticPoolByID),
 	},
 
 	"Microsoft.Security/subAssessments": {
@@ -1877,7 +1884,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SecurityCenterSubAssessment),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SecurityCenterSubAssessmentByID),
 	},
 
 	"Microsoft.Compute/disks": {
@@ -1890,8 +1897,8 @@ var ResourceTypes = map[string]model.ResourceType{
         },
 		Annotations:          map[string]string{
         },
-		ListDescriber:        DescribeBySubscription(describer.ComputeDisk),
-		GetDescriber:         nil,
+		ListDescriber:        DescribeByResourceGraph(describer.GenericResourceGraph{Table: "Resources", Type: "Microsoft.Compute/disks", Describe: describer.ResourceGraphDescription[azuremodel.ComputeDiskDescription]}),
+		GetDescriber:         GetBySubscription(describer.ComputeDiskByID),
 	},
 
 	"Microsoft.Devices/ProvisioningServices": {
@@ -1906,7 +1913,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.IOTHubDps),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.IOTHubDpsByID),
 	},
 
 	"Microsoft.HDInsight/clusters": {
@@ -1921,7 +1928,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.HdInsightCluster),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.HdInsightClusterByID),
 	},
 
 	"Microsoft.ServiceFabric/clusters": {
@@ -1936,7 +1943,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ServiceFabricCluster),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ServiceFabricClusterByID),
 	},
 
 	"Microsoft.SignalRService/signalR": {
@@ -1951,7 +1958,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SignalrService),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SignalrServiceByID),
 	},
 
 	"Microsoft.Storage/storageAccounts/blob": {
@@ -1981,7 +1988,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.StorageContainer),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.StorageContainerByID),
 	},
 
 	"Microsoft.Storage/storageAccounts/blobServices": {
@@ -1996,7 +2003,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.StorageBlobService),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.StorageBlobServiceByID),
 	},
 
 	"Microsoft.Storage/storageAccounts/queueServices": {
@@ -2011,7 +2018,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.StorageQueue),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.StorageQueueByID),
 	},
 
 	"Microsoft.ApiManagement/service": {
@@ -2026,7 +2033,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.APIManagement),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.APIManagementByID),
 	},
 
 	"Microsoft.ApiManagement/backend": {
@@ -2041,7 +2048,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.APIManagementBackend),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.APIManagementBackendByID),
 	},
 
 	"Microsoft.Compute/disksreadops": {
@@ -2069,7 +2076,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeVirtualMachineScaleSet),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeVirtualMachineScaleSetByID),
 	},
 
 	"Microsoft.DataFactory/factories/datasets": {
@@ -2084,7 +2091,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DataFactoryDataset),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DataFactoryDatasetByID),
 	},
 
 	"Microsoft.Authorization/policyDefinitions": {
@@ -2099,7 +2106,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.PolicyDefinition),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.PolicyDefinitionByID),
 	},
 
 	"Microsoft.Resources/subscriptions/locations": {
@@ -2127,7 +2134,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeDiskAccess),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeDiskAccessByID),
 	},
 
 	"Microsoft.DBforMySQL/servers": {
@@ -2142,7 +2149,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.MysqlServer),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.MysqlServerByID),
 	},
 
 	"Microsoft.DBforMySQL/flexibleservers": {
@@ -2157,7 +2164,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.MysqlFlexibleservers),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.MysqlFlexibleserverByID),
 	},
 
 	"Microsoft.Cache/redisenterprise": {
@@ -2172,7 +2179,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.CacheRedisEnterprise),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.CacheRedisEnterpriseByID),
 	},
 
 	"Microsoft.DataLakeAnalytics/accounts": {
@@ -2187,7 +2194,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DataLakeAnalyticsAccount),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DataLakeAnalyticsAccountByID),
 	},
 
 	"Microsoft.Insights/activityLogAlerts": {
@@ -2200,7 +2207,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LogAlert),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LogAlertByID),
 	},
 
 	"Microsoft.Compute/virtualMachineCpuUtilizationHourly": {
@@ -2228,7 +2235,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LoadBalancerOutboundRule),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LoadBalancerOutboundRuleByID),
 	},
 
 	"Microsoft.HybridCompute/machines": {
@@ -2242,7 +2249,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.HybridComputeMachine),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.HybridComputeMachineByID),
 	},
 
 	"Microsoft.Network/loadBalancers/inboundNatRules": {
@@ -2257,7 +2264,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LoadBalancerNatRule),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LoadBalancerNatRuleByID),
 	},
 
 	"Microsoft.Resources/providers": {
@@ -2270,7 +2277,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ResourceProvider),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ResourceProviderByID),
 	},
 
 	"Microsoft.Network/routeTables": {
@@ -2285,7 +2292,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.RouteTables),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.RouteTableByID),
 	},
 
 	"Microsoft.DocumentDB/databaseAccounts": {
@@ -2300,7 +2307,8 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.CosmosdbAccount),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.CosmosdbAccountByID),
+		Redact:               []string{"$..PrimaryMasterKey", "$..SecondaryMasterKey", "$..PrimaryReadonlyMasterKey", "$..SecondaryReadonlyMasterKey"},
 	},
 
 	"Microsoft.DocumentDB/restorableDatabaseAccounts": {
@@ -2315,7 +2323,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.CosmosdbRestorableDatabaseAccount),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.CosmosdbRestorableDatabaseAccountByID),
 	},
 
 	"Microsoft.Network/applicationGateways": {
@@ -2330,7 +2338,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ApplicationGateway),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ApplicationGatewayByID),
 	},
 
 	"Microsoft.Security/automations": {
@@ -2343,7 +2351,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SecurityCenterAutomation),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SecurityCenterAutomationByID),
 	},
 
 	"Microsoft.Kubernetes/connectedClusters": {
@@ -2358,7 +2366,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.HybridKubernetesConnectedCluster),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.HybridKubernetesConnectedClusterByID),
 	},
 
 	"Microsoft.KeyVault/vaults/keys": {
@@ -2373,7 +2381,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.KeyVaultKey),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.KeyVaultKeyByID),
 	},
 
 	"Microsoft.KeyVault/vaults/certificates": {
@@ -2403,7 +2411,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.KeyVaultKey),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.KeyVaultKeyByID),
 	},
 
 	"Microsoft.DBforMariaDB/servers": {
@@ -2418,7 +2426,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.MariadbServer),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.MariadbServerByID),
 	},
 
 	"Microsoft.DBforMariaDB/servers/databases": {
@@ -2433,7 +2441,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.MariadbDatabases),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.MariadbDatabaseByID),
 	},
 
 	"Microsoft.Compute/disksreadopsdaily": {
@@ -2460,7 +2468,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AppServicePlan),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AppServicePlanByID),
 	},
 
 	"Microsoft.Compute/disksreadopshourly": {
@@ -2514,7 +2522,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.VirtualNetworkGateway),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.VirtualNetworkGatewayByID),
 	},
 
 	"Microsoft.Devices/iotHubs": {
@@ -2529,7 +2537,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.IOTHub),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.IOTHubByID),
 	},
 
 	"Microsoft.Logic/workflows": {
@@ -2543,7 +2551,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LogicAppWorkflow),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LogicAppWorkflowByID),
 	},
 
 	"Microsoft.Sql/flexibleServers": {
@@ -2558,7 +2566,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SqlServerFlexibleServer),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SqlServerFlexibleServerByID),
 	},
 
 	"Microsoft.Resources/links": {
@@ -2572,7 +2580,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ResourceLink),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ResourceLinkByID),
 	},
 
 	"Microsoft.Resources/subscriptions": {
@@ -2586,7 +2594,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.Subscription),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SubscriptionByID),
 	},
 
 	"Microsoft.Compute/images": {
@@ -2601,7 +2609,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeImage),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeImageByID),
 	},
 
 	"Microsoft.Compute/virtualMachines": {
@@ -2616,7 +2624,8 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeVirtualMachine),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeVirtualMachineByID),
+		Redact:               []string{"$..customData", "$..commandToExecute"},
 	},
 
 	"Microsoft.Network/natGateways": {
@@ -2631,7 +2640,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NatGateway),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NatGatewayByID),
 	},
 
 	"Microsoft.Network/loadBalancers/probes": {
@@ -2646,7 +2655,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LoadBalancerProbe),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LoadBalancerProbeByID),
 	},
 
 	"Microsoft.KeyVault/vaults": {
@@ -2661,7 +2670,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.KeyVault),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.KeyVaultByID),
 	},
 
 	"Microsoft.KeyVault/managedHsms": {
@@ -2676,7 +2685,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.KeyVaultManagedHardwareSecurityModule),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.KeyVaultManagedHardwareSecurityModuleByID),
 	},
 
 	"Microsoft.KeyVault/vaults/secrets": {
@@ -2691,7 +2700,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.KeyVaultSecret),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.KeyVaultSecretByID),
 	},
 
 	"Microsoft.AppConfiguration/configurationStores": {
@@ -2706,7 +2715,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.AppConfiguration),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.AppConfigurationByID),
 	},
 
 	"Microsoft.Compute/virtualMachineCpuUtilization": {
@@ -2734,7 +2743,8 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.StorageAccount),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.StorageAccountByID),
+		Redact:               []string{"$..AccessKeys[*].Value"},
 	},
 
 	"Microsoft.AppPlatform/Spring": {
@@ -2749,7 +2759,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SpringCloudService),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SpringCloudServiceByID),
 	},
 
 	"Microsoft.Compute/galleries": {
@@ -2764,7 +2774,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeGallery),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeGalleryByID),
 	},
 
 	"Microsoft.Compute/hostGroups": {
@@ -2779,7 +2789,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeHostGroup),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeHostGroupByID),
 	},
 
 	"Microsoft.Compute/hostGroups/hosts": {
@@ -2794,7 +2804,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeHost),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeHostByID),
 	},
 
 	"Microsoft.Compute/restorePointCollections": {
@@ -2808,7 +2818,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeRestorePointCollection),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeRestorePointCollectionByID),
 	},
 
 	"Microsoft.Compute/sshPublicKeys": {
@@ -2823,7 +2833,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ComputeSSHPublicKey),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ComputeSSHPublicKeyByID),
 	},
 
 	"Microsoft.Cdn/profiles/endpoints": {
@@ -2838,7 +2848,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.CdnEndpoint),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.CdnEndpointByID),
 	},
 
 	"Microsoft.BotService/botServices": {
@@ -2853,7 +2863,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.BotServiceBot),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.BotServiceBotByID),
 	},
 
 	"Microsoft.DocumentDB/cassandraClusters": {
@@ -2868,7 +2878,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DocumentDBCassandraCluster),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DocumentDBCassandraClusterByID),
 	},
 
 	"Microsoft.Network/ddosProtectionPlans": {
@@ -2883,7 +2893,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetworkDDoSProtectionPlan),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetworkDDoSProtectionPlanByID),
 	},
 
 	"microsoft.Sql/instancePools": {
@@ -2898,7 +2908,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.SqlInstancePool),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.SqlInstancePoolByID),
 	},
 
 	"microsoft.NetApp/netAppAccounts": {
@@ -2913,7 +2923,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetAppAccount),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetAppAccountByID),
 	},
 
 	"Microsoft.NetApp/netAppAccounts/capacityPools": {
@@ -2928,7 +2938,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.NetAppCapacityPool),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.NetAppCapacityPoolByID),
 	},
 
 	"Microsoft.DesktopVirtualization/hostpools": {
@@ -2943,7 +2953,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DesktopVirtualizationHostPool),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DesktopVirtualizationHostPoolByID),
 	},
 
 	"Microsoft.Devtestlab/labs": {
@@ -2958,7 +2968,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.DevTestLabLab),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.DevTestLabLabByID),
 	},
 
 	"Microsoft.Purview/Accounts": {
@@ -2973,7 +2983,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.PurviewAccount),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.PurviewAccountByID),
 	},
 
 	"Microsoft.PowerBIDedicated/capacities": {
@@ -2988,7 +2998,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.PowerBIDedicatedCapacity),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.PowerBIDedicatedCapacityByID),
 	},
 
 	"Microsoft.Insights/components": {
@@ -3003,7 +3013,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.ApplicationInsights),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.ApplicationInsightsByID),
 	},
 
 	"Microsoft.Lighthouse/definition": {
@@ -3018,7 +3028,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LighthouseDefinition),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LighthouseDefinitionByID),
 	},
 
 	"Microsoft.Lighthouse/assignment": {
@@ -3033,7 +3043,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.LighthouseAssignments),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.LighthouseAssignmentByID),
 	},
 
 	"Microsoft.Maintenance/maintenanceConfigurations": {
@@ -3048,7 +3058,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.MaintenanceConfiguration),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.MaintenanceConfigurationByID),
 	},
 
 	"Microsoft.Monitor/logProfiles": {
@@ -3063,7 +3073,7 @@ var ResourceTypes = map[string]model.ResourceType{
 		Annotations:          map[string]string{
         },
 		ListDescriber:        DescribeBySubscription(describer.MonitorLogProfiles),
-		GetDescriber:         nil,
+		GetDescriber:         GetBySubscription(describer.MonitorLogProfileByID),
 	},
 
 	"Microsoft.Resources/subscriptions/resources": {
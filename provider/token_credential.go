@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/opengovern/og-describer-azure/provider/configs"
+	"github.com/opengovern/og-describer-azure/provider/describer"
+	"os"
+	"strings"
+)
+
+// NewTokenCredential builds the credential the integration config asks for.
+func NewTokenCredential(cfg configs.IntegrationCredentials) (azcore.TokenCredential, error) {
+	c, err := describer.CloudByName(cfg.Cloud)
+	if err != nil {
+		return nil, err
+	}
+	// the authority host of the cloud
+	clientOpts := azcore.ClientOptions{Cloud: c.Configuration}
+
+	switch cfg.Type() {
+	case configs.CredentialTypeClientSecret:
+		if cfg.ClientPassword == "" {
+			return nil, errors.New("either a client secret or a certificate is required")
+		}
+		return azidentity.NewClientSecretCredential(cfg.TenantID, cfg.ClientID, cfg.ClientPassword, &azidentity.ClientSecretCredentialOptions{ClientOptions: clientOpts})
+	case configs.CredentialTypeClientCertificate:
+		return newClientCertificateCredential(cfg, clientOpts)
+	case configs.CredentialTypeManagedIdentity:
+		opts := azidentity.ManagedIdentityCredentialOptions{ClientOptions: clientOpts}
+		// a user assigned identity, the system assigned one otherwise
+		if cfg.ClientID != "" {
+			opts.ID = azidentity.ClientID(cfg.ClientID)
+		}
+		return azidentity.NewManagedIdentityCredential(&opts)
+	case configs.CredentialTypeWorkloadIdentity:
+		// what the config leaves empty comes from the AZURE_* variables the
+		// workload identity webhook sets
+		return azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			ClientOptions: clientOpts,
+			TenantID:      cfg.TenantID,
+			ClientID:      cfg.ClientID,
+			TokenFilePath: cfg.FederatedTokenFile,
+		})
+	case configs.CredentialTypeFederated:
+		if cfg.TenantID == "" || cfg.ClientID == "" {
+			return nil, errors.New("federated credentials require a tenant id and a client id")
+		}
+		tokenFile := federatedTokenFile(cfg)
+		if tokenFile == "" {
+			return nil, errors.New("federated credentials require a token file")
+		}
+		// the token is rotated by kubernetes, so it is read on every exchange
+		return azidentity.NewClientAssertionCredential(cfg.TenantID, cfg.ClientID, func(context.Context) (string, error) {
+			token, err := os.ReadFile(tokenFile)
+			if err != nil {
+				return "", fmt.Errorf("failed to read federated token: %w", err)
+			}
+			return strings.TrimSpace(string(token)), nil
+		}, &azidentity.ClientAssertionCredentialOptions{ClientOptions: clientOpts})
+	}
+	return nil, fmt.Errorf("unknown credential type %s", cfg.CredentialType)
+}
+
+func newClientCertificateCredential(cfg configs.IntegrationCredentials, clientOpts azcore.ClientOptions) (azcore.TokenCredential, error) {
+	if cfg.Certificate == "" {
+		return nil, errors.New("client certificate credentials require a certificate")
+	}
+	certData := []byte(cfg.Certificate)
+	// PEM is accepted as is, anything else is expected to be base64
+	if !strings.Contains(cfg.Certificate, "-----BEGIN") {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(cfg.Certificate))
+		if err != nil {
+			return nil, fmt.Errorf("certificate is neither PEM nor base64: %w", err)
+		}
+		certData = decoded
+	}
+
+	var password []byte
+	if cfg.CertificatePassword != "" {
+		password = []byte(cfg.CertificatePassword)
+	}
+	certs, key, err := azidentity.ParseCertificates(certData, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+	return azidentity.NewClientCertificateCredential(cfg.TenantID, cfg.ClientID, certs, key, &azidentity.ClientCertificateCredentialOptions{ClientOptions: clientOpts})
+}
+
+func federatedTokenFile(cfg configs.IntegrationCredentials) string {
+	if cfg.FederatedTokenFile != "" {
+		return cfg.FederatedTokenFile
+	}
+	return os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+}
@@ -0,0 +1,143 @@
+package local
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/opengovern/og-describer-azure/pkg/describer"
+	"github.com/opengovern/og-describer-azure/pkg/redact"
+	"github.com/opengovern/og-describer-azure/provider/configs"
+	azuredescriber "github.com/opengovern/og-describer-azure/provider/describer"
+	describe2 "github.com/opengovern/og-util/pkg/describe"
+	"github.com/opengovern/og-util/pkg/describe/enums"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"io"
+	"os"
+	"time"
+)
+
+// DescribeCommand describes resource types of a subscription locally and
+// writes the resources and their lookup documents as JSONL.
+func DescribeCommand() *cobra.Command {
+	var (
+		tenantID       string
+		clientID       string
+		clientSecret   string
+		certPath       string
+		certPassword   string
+		credentialType string
+		cloud          string
+		subscriptionID string
+		resourceTypes  []string
+		integrationID  string
+		output         string
+		partialSuccess bool
+		tenant         bool
+		groupID        string
+		concurrency    int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Describe resource types locally and write the results as JSONL",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			cmd.SilenceUsage = true
+			logger, err := redact.NewDevelopmentLogger()
+			if err != nil {
+				return err
+			}
+			defer logger.Sync()
+
+			var w io.Writer = os.Stdout
+			if output != "" && output != "-" {
+				f, err := os.Create(output)
+				if err != nil {
+					return fmt.Errorf("failed to create output file: %w", err)
+				}
+				defer f.Close()
+				w = f
+			}
+
+			var creds configs.IntegrationCredentials
+			creds.TenantID = tenantID
+			creds.ClientID = clientID
+			creds.ClientPassword = clientSecret
+			creds.CredentialType = configs.CredentialType(credentialType)
+			creds.Cloud = cloud
+			if certPath != "" {
+				certData, err := os.ReadFile(certPath)
+				if err != nil {
+					return fmt.Errorf("failed to read certificate: %w", err)
+				}
+				creds.Certificate = base64.StdEncoding.EncodeToString(certData)
+				creds.CertificatePassword = certPassword
+			}
+
+			if integrationID == "" {
+				integrationID = subscriptionID
+				if tenant || groupID != "" {
+					integrationID = tenantID
+				}
+			}
+			job := describe2.DescribeJob{
+				IntegrationID:   integrationID,
+				ProviderID:      subscriptionID,
+				DescribedAt:     time.Now().UnixMilli(),
+				IntegrationType: configs.IntegrationName,
+				TriggerType:     enums.DescribeTriggerTypeManual,
+			}
+
+			if groupID != "" {
+				ctx = azuredescriber.WithManagementGroupScope(ctx, groupID, concurrency)
+			} else if tenant {
+				ctx = azuredescriber.WithTenantScope(ctx, concurrency)
+			}
+
+			// the types share the parents they list, e.g. the storage accounts
+			ctx = azuredescriber.WithParentCache(ctx, azuredescriber.NewParentCache())
+			sink := describer.NewWriterSink(w)
+			for _, resourceType := range resourceTypes {
+				job.ResourceType = resourceType
+				typeCtx := ctx
+				if partialSuccess {
+					typeCtx = azuredescriber.WithPartialSuccess(ctx)
+				}
+				resourceIDs, err := describer.DescribeLocal(typeCtx, logger, job, creds, sink)
+				logger.Info("describe finished", zap.String("resourceType", resourceType), zap.Int("resources", len(resourceIDs)))
+				var partialErr *describer.PartialError
+				if errors.As(err, &partialErr) {
+					for _, f := range partialErr.Failures {
+						logger.Warn("resource not fully described", zap.String("resourceID", f.ResourceID),
+							zap.String("operation", f.Operation), zap.String("errorCode", f.ErrorCode), zap.String("error", f.Error))
+					}
+					continue
+				}
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tenantID, "tenant-id", os.Getenv("AZURE_TENANT_ID"), "Azure tenant id")
+	cmd.Flags().StringVar(&clientID, "client-id", os.Getenv("AZURE_CLIENT_ID"), "Service principal client id")
+	cmd.Flags().StringVar(&clientSecret, "client-secret", os.Getenv("AZURE_CLIENT_SECRET"), "Service principal client secret")
+	cmd.Flags().StringVar(&certPath, "certificate-path", os.Getenv("AZURE_CLIENT_CERTIFICATE_PATH"), "PEM or PFX client certificate, used instead of the client secret")
+	cmd.Flags().StringVar(&certPassword, "certificate-password", os.Getenv("AZURE_CLIENT_CERTIFICATE_PASSWORD"), "Password of the client certificate")
+	cmd.Flags().StringVar(&credentialType, "credential-type", "", "One of client_secret, client_certificate, managed_identity, workload_identity and federated, inferred from the other flags if empty")
+	cmd.Flags().StringVar(&cloud, "cloud", os.Getenv("AZURE_CLOUD"), "Azure cloud, one of AzurePublicCloud, AzureUSGovernment and AzureChinaCloud")
+	cmd.Flags().StringVar(&subscriptionID, "subscription-id", os.Getenv("AZURE_SUBSCRIPTION_ID"), "Subscription to describe")
+	cmd.Flags().StringSliceVar(&resourceTypes, "resource-type", nil, "Resource types to describe, e.g. Microsoft.Compute/virtualMachines, repeated or comma separated")
+	cmd.Flags().StringVar(&integrationID, "integration-id", "", "Integration id recorded on the resources, defaults to the subscription id")
+	cmd.Flags().StringVarP(&output, "output", "o", "-", "Output file, - for stdout")
+	cmd.Flags().BoolVar(&partialSuccess, "partial-success", false, "Skip or degrade the resources that can't be fully described instead of failing")
+	cmd.Flags().BoolVar(&tenant, "tenant", false, "Describe every subscription of the tenant instead of --subscription-id")
+	cmd.Flags().StringVar(&groupID, "management-group", "", "Describe every subscription under the management group instead of --subscription-id")
+	cmd.Flags().IntVar(&concurrency, "subscription-concurrency", 8, "Subscriptions described at a time with --tenant or --management-group")
+	_ = cmd.MarkFlagRequired("resource-type")
+
+	return cmd
+}
@@ -1,7 +1,13 @@
 package local
 
 import (
+	"context"
+	"time"
+
+	"github.com/opengovern/og-describer-azure/pkg/metrics"
+	"github.com/opengovern/og-describer-azure/pkg/redact"
 	"github.com/opengovern/og-describer-azure/pkg/sdk"
+	"github.com/opengovern/og-describer-azure/pkg/tracing"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -11,10 +17,23 @@ func WorkerCommand() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			cmd.SilenceUsage = true
-			logger, err := zap.NewProduction()
+			logger, err := redact.NewProductionLogger()
+			if err != nil {
+				return err
+			}
+			metrics.Serve(logger)
+			shutdownTracing, err := tracing.Init(ctx, logger)
 			if err != nil {
 				return err
 			}
+			defer func() {
+				// ctx is done by now
+				flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := shutdownTracing(flushCtx); err != nil {
+					logger.Error("failed to flush traces", zap.Error(err))
+				}
+			}()
 
 			w, err := sdk.NewWorker(
 				logger,
@@ -27,6 +46,7 @@ func WorkerCommand() *cobra.Command {
 			return w.Run(ctx)
 		},
 	}
+	cmd.AddCommand(DescribeCommand())
 
 	return cmd
 }